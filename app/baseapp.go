@@ -560,6 +560,9 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 
 // Implements ABCI
 func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
+	validTxCounter := app.deliverState.ctx.ValidTxCounter()
+	validTxCount := validTxCounter.Count()
+
 	// Decode the Tx.
 	var tx, err = app.txDecoder(txBytes)
 	var result sdk.Result
@@ -571,6 +574,11 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 
 	}
 
+	// Record the invalid tx for the proposer censorship slashing
+	if validTxCounter.Count() == validTxCount {
+		validTxCounter.AddInvalidTx(tmhash.Sum(txBytes))
+	}
+
 	// Even though the Result.Code is not OK, there are still effects,
 	// namely fee deductions and sequence incrementing.
 
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// StoreRootDiff is the root hash of a store before and after the upgrade dry run
+type StoreRootDiff struct {
+	Store   string       `json:"store"`
+	Before  cmn.HexBytes `json:"before"`
+	After   cmn.HexBytes `json:"after"`
+	Changed bool         `json:"changed"`
+}
+
+// UpgradeDryRunResult is the report of activating a protocol version on the current state
+type UpgradeDryRunResult struct {
+	Version         uint64          `json:"version"`
+	Height          int64           `json:"height"`
+	ChainID         string          `json:"chain_id"`
+	Time            time.Time       `json:"time"`
+	Success         bool            `json:"success"`
+	Panic           string          `json:"panic"`
+	InvariantErrors []string        `json:"invariant_errors"`
+	StoreRoots      []StoreRootDiff `json:"store_roots"`
+}
+
+func (r UpgradeDryRunResult) String() string {
+	status := "success"
+	if !r.Success {
+		status = "failure"
+	}
+	out := fmt.Sprintf(`Upgrade Dry Run:
+  Version:           %d
+  Height:            %d
+  Chain ID:          %s
+  Time:              %s
+  Result:            %s`, r.Version, r.Height, r.ChainID, r.Time.UTC().Format(time.RFC3339), status)
+	if len(r.Panic) > 0 {
+		out += fmt.Sprintf("\n  Panic:             %s", r.Panic)
+	}
+	for _, err := range r.InvariantErrors {
+		out += fmt.Sprintf("\n  Invariant Broken:  %s", err)
+	}
+	if len(r.StoreRoots) > 0 {
+		out += "\n  Store Roots:"
+		for _, root := range r.StoreRoots {
+			mark := " "
+			if root.Changed {
+				mark = "*"
+			}
+			out += fmt.Sprintf("\n  %s %-10s %s -> %s", mark, root.Store, root.Before, root.After)
+		}
+	}
+	return strings.TrimSpace(out)
+}
+
+// DryRunUpgrade activates the given protocol version on top of the latest committed state in a
+// cache multistore, and runs its Init and all its runtime invariants. Nothing is committed, so
+// the application data stays untouched. The context takes the chain-id and the time from the
+// header of the last committed block.
+func (app *IrisApp) DryRunUpgrade(version uint64, lastHeader abci.Header) (result UpgradeDryRunResult, err error) {
+	if lastHeader.Height != app.LastBlockHeight() {
+		return result, fmt.Errorf("header height %d doesn't match the last block height %d", lastHeader.Height, app.LastBlockHeight())
+	}
+	current := app.Engine.GetCurrentVersion()
+	if version <= current {
+		return result, fmt.Errorf("version %d must be greater than the current version %d", version, current)
+	}
+	p, found := app.Engine.GetByVersion(version)
+	if !found {
+		return result, fmt.Errorf("your software doesn't support the protocol version %d", version)
+	}
+
+	result.Version = version
+	// the protocol is activated at the end of the block before the switch height
+	result.Height = app.LastBlockHeight() + 1
+	result.ChainID = lastHeader.ChainID
+	result.Time = lastHeader.Time
+
+	msCache := app.cms.CacheMultiStore()
+	header := abci.Header{ChainID: result.ChainID, Height: result.Height, Time: result.Time}
+	ctx := sdk.NewContext(msCache, header, false, app.Logger)
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				result.Panic = fmt.Sprintf("%v", r)
+			}
+		}()
+
+		app.Engine.ProtocolKeeper.SetCurrentVersion(ctx, version)
+		p.Load()
+		p.Init(ctx)
+
+		for i, inv := range p.GetInvariants() {
+			if err := inv(ctx); err != nil {
+				result.InvariantErrors = append(result.InvariantErrors, fmt.Sprintf("invariant[%d]: %s", i, err))
+			}
+		}
+	}()
+	result.Success = len(result.Panic) == 0 && len(result.InvariantErrors) == 0
+	if len(result.Panic) > 0 {
+		return result, nil
+	}
+
+	// flush the changes into the working trees to compute the new roots, without saving a new version
+	msCache.Write()
+	for _, key := range app.Engine.GetKVStoreKeys() {
+		store := app.cms.GetCommitKVStore(key)
+		tree, ok := store.(interface{ WorkingHash() []byte })
+		if !ok {
+			continue
+		}
+		before := store.LastCommitID().Hash
+		after := tree.WorkingHash()
+		result.StoreRoots = append(result.StoreRoots, StoreRootDiff{
+			Store:   key.Name(),
+			Before:  before,
+			After:   after,
+			Changed: !bytes.Equal(before, after),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	v0 "github.com/irisnet/irishub/app/v0"
+	"github.com/irisnet/irishub/codec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	app := NewIrisApp(log.NewNopLogger(), dbm.NewMemDB(), cfg.DefaultInstrumentationConfig(), nil)
+
+	genesisState := v0.NewDefaultGenesisFileState()
+	stateBytes, err := codec.MarshalJSONIndent(app.Engine.GetCurrentProtocol().GetCodec(), genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{ChainId: "dry-run", AppStateBytes: stateBytes})
+
+	header := abci.Header{ChainID: "dry-run", Height: 1, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+	commitID := app.LastCommitID()
+
+	// the header must be the one of the last committed block
+	_, err = app.DryRunUpgrade(1, abci.Header{ChainID: "dry-run", Height: 2})
+	require.Error(t, err)
+	// only a later version can be activated
+	_, err = app.DryRunUpgrade(0, header)
+	require.Error(t, err)
+	_, err = app.DryRunUpgrade(100, header)
+	require.Error(t, err)
+
+	result, err := app.DryRunUpgrade(1, header)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.Version)
+	require.Equal(t, int64(2), result.Height)
+	require.Equal(t, "dry-run", result.ChainID)
+	require.True(t, header.Time.Equal(result.Time))
+	require.True(t, result.Success, result.String())
+	require.NotEmpty(t, result.StoreRoots)
+
+	// nothing is committed
+	require.Equal(t, commitID, app.LastCommitID())
+	require.Equal(t, uint64(0), app.Engine.GetCurrentVersion())
+}
@@ -17,6 +17,7 @@ type Protocol interface {
 	GetFeePreprocessHandler() sdk.FeePreprocessHandler // fee handler for fee preprocessor
 	ExportAppStateAndValidators(ctx sdk.Context, forZeroHeight bool) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error)
 	ValidateTx(ctx sdk.Context, txBytes []byte, msgs []sdk.Msg) sdk.Error
+	GetInvariants() []sdk.Invariant // invariants registered by the protocol
 
 	// may be nil
 	GetInitChainer() sdk.InitChainer1  // initialize state with validators and state blob
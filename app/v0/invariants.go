@@ -25,6 +25,11 @@ func (p *ProtocolV0) runtimeInvariants() []sdk.Invariant {
 	}
 }
 
+// GetInvariants returns the runtime invariants of this Protocol
+func (p *ProtocolV0) GetInvariants() []sdk.Invariant {
+	return p.runtimeInvariants()
+}
+
 func (p *ProtocolV0) assertRuntimeInvariants(ctx sdk.Context) {
 	if p.invariantLevel != sdk.InvariantError && p.invariantLevel != sdk.InvariantPanic {
 		return
@@ -0,0 +1,27 @@
+package asset
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// EndBlocker releases the token vestings which are due
+func EndBlocker(ctx sdk.Context, k Keeper) (tags sdk.Tags) {
+	ctx = ctx.WithCoinFlowTrigger(sdk.AssetEndBlocker)
+	ctx = ctx.WithLogger(ctx.Logger().With("handler", "endBlock").With("module", "iris/asset"))
+
+	tags = sdk.NewTags()
+	for _, vesting := range k.GetMaturedVestings(ctx) {
+		releaseTags, err := k.ReleaseVesting(ctx, vesting)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to release token vesting %s:%d: %s", vesting.TokenId, vesting.Index, err.Error()))
+			continue
+		}
+
+		tags = tags.AppendTags(releaseTags)
+		ctx.Logger().Info(fmt.Sprintf("token vesting %s:%d released %s to %s", vesting.TokenId, vesting.Index, vesting.Amount, vesting.Beneficiary))
+	}
+
+	return
+}
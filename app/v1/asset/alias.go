@@ -26,9 +26,13 @@ type (
 	QueryGatewaysParams     = types.QueryGatewaysParams
 	QueryGatewayFeeParams   = types.QueryGatewayFeeParams
 	QueryTokenFeesParams    = types.QueryTokenFeesParams
+	QueryVestingsParams     = types.QueryVestingsParams
 	GatewayFeeOutput        = types.GatewayFeeOutput
 	TokenFeesOutput         = types.TokenFeesOutput
 	GenesisState            = types.GenesisState
+	VestingEntry            = types.VestingEntry
+	TokenVesting            = types.TokenVesting
+	TokenVestings           = types.TokenVestings
 
 	Keeper = keeper.Keeper
 )
@@ -62,6 +66,8 @@ var (
 	NewMsgMintToken            = types.NewMsgMintToken
 	NewMsgTransferTokenOwner   = types.NewMsgTransferTokenOwner
 	NewMsgIssueToken           = types.NewMsgIssueToken
+	NewVestingEntry            = types.NewVestingEntry
+	NewTokenVesting            = types.NewTokenVesting
 	DefaultParams              = types.DefaultParams
 	DefaultParamsForTest       = types.DefaultParamsForTest
 	ValidateParams             = types.ValidateParams
@@ -71,6 +77,7 @@ var (
 	QueryGateway                = types.QueryGateway
 	QueryGateways               = types.QueryGateways
 	QueryFees                   = types.QueryFees
+	QueryVestings               = types.QueryVestings
 	NewKeeper                   = keeper.NewKeeper
 	TokenIssueFeeHandler        = keeper.TokenIssueFeeHandler
 	GatewayTokenIssueFeeHandler = keeper.GatewayTokenIssueFeeHandler
@@ -25,6 +25,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 			panic(err.Error())
 		}
 	}
+
+	// init pending vestings
+	for _, vesting := range data.Vestings {
+		k.AddVesting(ctx, vesting)
+	}
 }
 
 // ExportGenesis - output genesis parameters
@@ -42,10 +47,22 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		tokens = append(tokens, token)
 		return false
 	})
+
+	// export pending vestings, height-locked ones relative to the new chain
+	vestings := make(TokenVestings, 0)
+	k.IterateVestings(ctx, func(vesting TokenVesting) (stop bool) {
+		if vesting.IsHeightLocked() {
+			vesting.ReleaseHeight = vesting.ReleaseHeight - ctx.BlockHeight() + 1
+		}
+		vestings = append(vestings, vesting)
+		return false
+	})
+
 	return GenesisState{
 		Params:   k.GetParamSet(ctx),
 		Tokens:   tokens,
 		Gateways: gateways,
+		Vestings: vestings,
 	}
 }
 
@@ -55,6 +72,7 @@ func DefaultGenesisState() GenesisState {
 		Params:   DefaultParams(),
 		Tokens:   []FungibleToken{},
 		Gateways: []Gateway{},
+		Vestings: TokenVestings{},
 	}
 }
 
@@ -64,6 +82,7 @@ func DefaultGenesisStateForTest() GenesisState {
 		Params:   DefaultParamsForTest(),
 		Tokens:   []FungibleToken{},
 		Gateways: []Gateway{},
+		Vestings: TokenVestings{},
 	}
 }
 
@@ -83,6 +102,10 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Tokens.Validate(); err != nil {
 		return err
 	}
+	// validate vestings
+	if err := data.Vestings.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -66,8 +66,14 @@ func handleIssueToken(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Result {
 		return err.Result()
 	}
 
+	// lock the vesting parts of the initial supply
+	vestingTags, err := k.LockVestings(ctx, token, msg.Owner, msg.VestingSchedule)
+	if err != nil {
+		return err.Result()
+	}
+
 	return sdk.Result{
-		Tags: tags,
+		Tags: tags.AppendTags(vestingTags),
 	}
 }
 
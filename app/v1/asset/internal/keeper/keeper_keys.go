@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	sdk "github.com/irisnet/irishub/types"
 )
@@ -40,3 +42,67 @@ func KeyOwnerGateway(owner sdk.AccAddress, moniker string) []byte {
 func KeyGatewaysSubspace(owner sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("ownerGateways:%d:", owner))
 }
+
+var (
+	KeyDelimiter = []byte(":")
+
+	PrefixVesting            = []byte("vesting:")           // prefix for the token vesting store
+	PrefixVestingHeightQueue = []byte("vestingHeightQueue") // prefix for the height-locked vesting queue
+	PrefixVestingTimeQueue   = []byte("vestingTimeQueue")   // prefix for the time-locked vesting queue
+)
+
+// KeyVesting returns the key of the specified token vesting
+func KeyVesting(tokenId string, index uint64) []byte {
+	return []byte(fmt.Sprintf("vesting:%s:%d", tokenId, index))
+}
+
+// KeyVestingsSubspace returns the key prefix for iterating on all vestings of a token
+func KeyVestingsSubspace(tokenId string) []byte {
+	return []byte(fmt.Sprintf("vesting:%s:", tokenId))
+}
+
+// KeyBeneficiaryVesting returns the key of the specified beneficiary and token vesting. Intended for querying all vestings of a beneficiary
+func KeyBeneficiaryVesting(beneficiary sdk.AccAddress, tokenId string, index uint64) []byte {
+	return []byte(fmt.Sprintf("beneficiaryVesting:%s:%s:%d", beneficiary, tokenId, index))
+}
+
+// KeyBeneficiaryVestingsSubspace returns the key prefix for iterating on all vestings of a beneficiary
+func KeyBeneficiaryVestingsSubspace(beneficiary sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("beneficiaryVesting:%s:", beneficiary))
+}
+
+// KeyVestingHeightQueue returns the key of the token vesting in the height-locked vesting queue
+func KeyVestingHeightQueue(height int64, tokenId string, index uint64) []byte {
+	return bytes.Join([][]byte{
+		PrefixVestingHeightQueue,
+		sdk.Uint64ToBigEndian(uint64(height)),
+		[]byte(tokenId),
+		sdk.Uint64ToBigEndian(index),
+	}, KeyDelimiter)
+}
+
+// PrefixVestingHeightQueueHeight returns the key prefix of the height-locked vesting queue up to the given height
+func PrefixVestingHeightQueueHeight(height int64) []byte {
+	return bytes.Join([][]byte{
+		PrefixVestingHeightQueue,
+		sdk.Uint64ToBigEndian(uint64(height)),
+	}, KeyDelimiter)
+}
+
+// KeyVestingTimeQueue returns the key of the token vesting in the time-locked vesting queue
+func KeyVestingTimeQueue(releaseTime time.Time, tokenId string, index uint64) []byte {
+	return bytes.Join([][]byte{
+		PrefixVestingTimeQueue,
+		sdk.FormatTimeBytes(releaseTime),
+		[]byte(tokenId),
+		sdk.Uint64ToBigEndian(index),
+	}, KeyDelimiter)
+}
+
+// PrefixVestingTimeQueueTime returns the key prefix of the time-locked vesting queue up to the given time
+func PrefixVestingTimeQueueTime(releaseTime time.Time) []byte {
+	return bytes.Join([][]byte{
+		PrefixVestingTimeQueue,
+		sdk.FormatTimeBytes(releaseTime),
+	}, KeyDelimiter)
+}
@@ -22,6 +22,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGateways(ctx, req, k)
 		case types.QueryFees:
 			return queryFees(ctx, path[1:], req, k)
+		case types.QueryVestings:
+			return queryVestings(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown asset query endpoint")
 		}
@@ -314,3 +316,38 @@ func queryTokenFees(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 
 	return bz, nil
 }
+
+func queryVestings(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVestingsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	var vestings types.TokenVestings
+
+	if len(params.TokenId) > 0 {
+		if err := types.CheckTokenID(params.TokenId); err != nil {
+			return nil, err
+		}
+
+		vestings = make(types.TokenVestings, 0)
+		for _, vesting := range keeper.GetTokenVestings(ctx, params.TokenId) {
+			// filter by the beneficiary if provided
+			if params.Beneficiary.Empty() || vesting.Beneficiary.Equals(params.Beneficiary) {
+				vestings = append(vestings, vesting)
+			}
+		}
+	} else if !params.Beneficiary.Empty() {
+		vestings = keeper.GetBeneficiaryVestings(ctx, params.Beneficiary)
+	} else {
+		return nil, sdk.ErrUnknownRequest("either token id or beneficiary is required for querying vestings")
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, vestings)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// LockVestings moves the vesting parts of the initial supply from the owner to the vesting account
+func (k Keeper) LockVestings(ctx sdk.Context, token types.FungibleToken, owner sdk.AccAddress, schedule []types.VestingEntry) (sdk.Tags, sdk.Error) {
+	tags := sdk.EmptyTags()
+	if len(schedule) == 0 {
+		return tags, nil
+	}
+
+	tokenId := token.GetUniqueID()
+	for i, entry := range schedule {
+		if err := entry.Validate(); err != nil {
+			return nil, err
+		}
+
+		if entry.IsHeightLocked() && entry.ReleaseHeight <= ctx.BlockHeight() {
+			return nil, types.ErrInvalidVesting(k.codespace, fmt.Sprintf("the release height %d must be greater than the current height %d", entry.ReleaseHeight, ctx.BlockHeight()))
+		}
+
+		if !entry.IsHeightLocked() && !entry.ReleaseTime.After(ctx.BlockHeader().Time) {
+			return nil, types.ErrInvalidVesting(k.codespace, fmt.Sprintf("the release time %s must be after the current block time %s", entry.ReleaseTime, ctx.BlockHeader().Time))
+		}
+
+		amount := sdk.NewCoin(token.GetDenom(), sdk.NewIntWithDecimal(int64(entry.Amount), int(token.GetDecimal())))
+		if _, err := k.bk.SendCoins(ctx, owner, auth.TokenVestingCoinsAccAddr, sdk.Coins{amount}); err != nil {
+			return nil, err
+		}
+
+		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, owner.String(), auth.TokenVestingCoinsAccAddr.String(), amount.String(), sdk.TokenVestingLockFlow, "")
+
+		vesting := types.NewTokenVesting(tokenId, uint64(i), entry.ReleaseHeight, entry.ReleaseTime, amount, entry.Beneficiary)
+		k.AddVesting(ctx, vesting)
+
+		tags = tags.AppendTag(types.TagBeneficiary, []byte(entry.Beneficiary.String()))
+	}
+
+	return tags, nil
+}
+
+// ReleaseVesting sends the locked coins of the given vesting to its beneficiary and removes the vesting
+func (k Keeper) ReleaseVesting(ctx sdk.Context, vesting types.TokenVesting) (sdk.Tags, sdk.Error) {
+	if _, err := k.bk.SendCoins(ctx, auth.TokenVestingCoinsAccAddr, vesting.Beneficiary, sdk.Coins{vesting.Amount}); err != nil {
+		return nil, err
+	}
+
+	ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.TokenVestingCoinsAccAddr.String(), vesting.Beneficiary.String(), vesting.Amount.String(), sdk.TokenVestingReleaseFlow, "")
+
+	k.DeleteVesting(ctx, vesting)
+
+	releaseTags := sdk.NewTags(
+		types.TagId, []byte(vesting.TokenId),
+		types.TagBeneficiary, []byte(vesting.Beneficiary.String()),
+	)
+
+	return releaseTags, nil
+}
+
+// AddVesting stores the given vesting along with its beneficiary index and release queue entry
+func (k Keeper) AddVesting(ctx sdk.Context, vesting types.TokenVesting) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := KeyVesting(vesting.TokenId, vesting.Index)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(vesting))
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(key)
+	store.Set(KeyBeneficiaryVesting(vesting.Beneficiary, vesting.TokenId, vesting.Index), bz)
+
+	if vesting.IsHeightLocked() {
+		store.Set(KeyVestingHeightQueue(vesting.ReleaseHeight, vesting.TokenId, vesting.Index), bz)
+	} else {
+		store.Set(KeyVestingTimeQueue(vesting.ReleaseTime, vesting.TokenId, vesting.Index), bz)
+	}
+}
+
+// DeleteVesting removes the given vesting along with its beneficiary index and release queue entry
+func (k Keeper) DeleteVesting(ctx sdk.Context, vesting types.TokenVesting) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(KeyVesting(vesting.TokenId, vesting.Index))
+	store.Delete(KeyBeneficiaryVesting(vesting.Beneficiary, vesting.TokenId, vesting.Index))
+
+	if vesting.IsHeightLocked() {
+		store.Delete(KeyVestingHeightQueue(vesting.ReleaseHeight, vesting.TokenId, vesting.Index))
+	} else {
+		store.Delete(KeyVestingTimeQueue(vesting.ReleaseTime, vesting.TokenId, vesting.Index))
+	}
+}
+
+// GetVesting retrieves the vesting of the given token and index
+func (k Keeper) GetVesting(ctx sdk.Context, tokenId string, index uint64) (vesting types.TokenVesting, found bool) {
+	return k.getVestingByKey(ctx, KeyVesting(tokenId, index))
+}
+
+func (k Keeper) getVestingByKey(ctx sdk.Context, key []byte) (vesting types.TokenVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return vesting, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vesting)
+	return vesting, true
+}
+
+// GetTokenVestings retrieves all pending vestings of the given token
+func (k Keeper) GetTokenVestings(ctx sdk.Context, tokenId string) types.TokenVestings {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, KeyVestingsSubspace(tokenId))
+	defer iterator.Close()
+
+	vestings := make(types.TokenVestings, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var vesting types.TokenVesting
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vesting)
+		vestings = append(vestings, vesting)
+	}
+
+	return vestings
+}
+
+// GetBeneficiaryVestings retrieves all pending vestings of the given beneficiary
+func (k Keeper) GetBeneficiaryVestings(ctx sdk.Context, beneficiary sdk.AccAddress) types.TokenVestings {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, KeyBeneficiaryVestingsSubspace(beneficiary))
+	defer iterator.Close()
+
+	vestings := make(types.TokenVestings, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var key []byte
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &key)
+
+		if vesting, found := k.getVestingByKey(ctx, key); found {
+			vestings = append(vestings, vesting)
+		}
+	}
+
+	return vestings
+}
+
+// IterateVestings iterates through all pending vestings
+func (k Keeper) IterateVestings(ctx sdk.Context, op func(vesting types.TokenVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PrefixVesting)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vesting types.TokenVesting
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vesting)
+
+		if stop := op(vesting); stop {
+			break
+		}
+	}
+}
+
+// GetMaturedVestings retrieves all vestings which are due at the current block height or time
+func (k Keeper) GetMaturedVestings(ctx sdk.Context) types.TokenVestings {
+	store := ctx.KVStore(k.storeKey)
+	vestings := make(types.TokenVestings, 0)
+
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			var key []byte
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &key)
+
+			if vesting, found := k.getVestingByKey(ctx, key); found {
+				vestings = append(vestings, vesting)
+			}
+		}
+	}
+
+	collect(store.Iterator(PrefixVestingHeightQueue, sdk.PrefixEndBytes(PrefixVestingHeightQueueHeight(ctx.BlockHeight()))))
+	collect(store.Iterator(PrefixVestingTimeQueue, sdk.PrefixEndBytes(PrefixVestingTimeQueueTime(ctx.BlockHeader().Time))))
+
+	return vestings
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/tests"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestKeeper_LockAndReleaseVestings(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	blockTime := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: blockTime}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+
+	owner := sdk.AccAddress([]byte("owner"))
+	beneficiary1 := sdk.AccAddress([]byte("beneficiary1"))
+	beneficiary2 := sdk.AccAddress([]byte("beneficiary2"))
+
+	ft := types.NewFungibleToken(types.NATIVE, "", "btc", "btc", 0, "", "satoshi", sdk.NewInt(1000), sdk.NewInt(1000), false, owner)
+	_, err := keeper.IssueToken(ctx, ft)
+	require.NoError(t, err)
+
+	// release height not in the future
+	_, err = keeper.LockVestings(ctx, ft, owner, []types.VestingEntry{types.NewVestingEntry(10, time.Time{}, 100, beneficiary1)})
+	require.Error(t, err)
+
+	schedule := []types.VestingEntry{
+		types.NewVestingEntry(20, time.Time{}, 100, beneficiary1),
+		types.NewVestingEntry(0, blockTime.Add(time.Hour), 300, beneficiary2),
+	}
+	_, err = keeper.LockVestings(ctx, ft, owner, schedule)
+	require.NoError(t, err)
+
+	require.Equal(t, "600", bk.GetCoins(ctx, owner).AmountOf("btc-min").String())
+	require.Equal(t, "400", bk.GetCoins(ctx, auth.TokenVestingCoinsAccAddr).AmountOf("btc-min").String())
+	require.Len(t, keeper.GetTokenVestings(ctx, "btc"), 2)
+	require.Len(t, keeper.GetBeneficiaryVestings(ctx, beneficiary1), 1)
+	require.Len(t, keeper.GetMaturedVestings(ctx), 0)
+
+	// the height-locked vesting is due
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 20, Time: blockTime.Add(time.Minute)})
+	matured := keeper.GetMaturedVestings(ctx)
+	require.Len(t, matured, 1)
+	require.Equal(t, beneficiary1, matured[0].Beneficiary)
+
+	_, err = keeper.ReleaseVesting(ctx, matured[0])
+	require.NoError(t, err)
+	require.Equal(t, "100", bk.GetCoins(ctx, beneficiary1).AmountOf("btc-min").String())
+	require.Len(t, keeper.GetBeneficiaryVestings(ctx, beneficiary1), 0)
+
+	// the time-locked vesting is due
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 21, Time: blockTime.Add(time.Hour)})
+	matured = keeper.GetMaturedVestings(ctx)
+	require.Len(t, matured, 1)
+
+	_, err = keeper.ReleaseVesting(ctx, matured[0])
+	require.NoError(t, err)
+	require.Equal(t, "300", bk.GetCoins(ctx, beneficiary2).AmountOf("btc-min").String())
+	require.True(t, bk.GetCoins(ctx, auth.TokenVestingCoinsAccAddr).AmountOf("btc-min").IsZero())
+	require.Len(t, keeper.GetTokenVestings(ctx, "btc"), 0)
+}
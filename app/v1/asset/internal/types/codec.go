@@ -19,6 +19,7 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(&Params{}, "irishub/asset/Params", nil)
 	cdc.RegisterConcrete(&Gateway{}, "irishub/asset/Gateway", nil)
+	cdc.RegisterConcrete(&TokenVesting{}, "irishub/asset/TokenVesting", nil)
 }
 
 var msgCdc = codec.New()
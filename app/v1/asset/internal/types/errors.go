@@ -34,6 +34,7 @@ const (
 	CodeUnauthorizedIssueGatewayAsset sdk.CodeType = 121
 	CodeAssetNotExists                sdk.CodeType = 122
 	CodeAssetNotMintable              sdk.CodeType = 123
+	CodeInvalidVesting                sdk.CodeType = 124
 
 	CodeInsufficientCoins       sdk.CodeType = 130
 	CodeSignersMissingInContext sdk.CodeType = 131
@@ -93,6 +94,10 @@ func ErrAssetNotMintable(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeAssetNotMintable, msg)
 }
 
+func ErrInvalidVesting(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, msg)
+}
+
 //----------------------------------------
 // Gateway error constructors
 
@@ -2,7 +2,8 @@ package types
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params   Params    `json:"params"`   // asset params
-	Tokens   Tokens    `json:"tokens"`   // issued tokens
-	Gateways []Gateway `json:"gateways"` // created gateways
+	Params   Params        `json:"params"`   // asset params
+	Tokens   Tokens        `json:"tokens"`   // issued tokens
+	Gateways []Gateway     `json:"gateways"` // created gateways
+	Vestings TokenVestings `json:"vestings"` // pending token vestings
 }
@@ -54,6 +54,7 @@ type MsgIssueToken struct {
 	MaxSupply       uint64         `json:"max_supply"`
 	Mintable        bool           `json:"mintable"`
 	Owner           sdk.AccAddress `json:"owner"`
+	VestingSchedule []VestingEntry `json:"vesting_schedule,omitempty"` // optional time-locked parts of the initial supply
 }
 
 // NewMsgIssueToken - construct asset issue msg.
@@ -149,6 +150,10 @@ func ValidateMsgIssueToken(msg *MsgIssueToken) sdk.Error {
 	if msg.Decimal > MaximumAssetDecimal {
 		return ErrInvalidAssetDecimal(DefaultCodespace, fmt.Sprintf("invalid token decimal %d, only accepts value [0, %d]", msg.Decimal, MaximumAssetDecimal))
 	}
+
+	if err := validateVestingSchedule(msg.VestingSchedule, msg.InitialSupply); err != nil {
+		return err
+	}
 	return nil
 }
 
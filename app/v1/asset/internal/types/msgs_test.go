@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/stretchr/testify/require"
@@ -59,6 +60,40 @@ func TestMsgIssueAsset(t *testing.T) {
 	}
 }
 
+func TestMsgIssueTokenVestingSchedule(t *testing.T) {
+	addr := sdk.AccAddress("test")
+	beneficiary := sdk.AccAddress("beneficiary")
+	releaseTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testCase   string
+		schedule   []VestingEntry
+		expectPass bool
+	}{
+		{"no vesting", nil, true},
+		{"height locked", []VestingEntry{NewVestingEntry(100, time.Time{}, 40, beneficiary)}, true},
+		{"time locked", []VestingEntry{NewVestingEntry(0, releaseTime, 40, beneficiary)}, true},
+		{"whole initial supply locked", []VestingEntry{NewVestingEntry(100, time.Time{}, 60, beneficiary), NewVestingEntry(0, releaseTime, 40, addr)}, true},
+		{"both height and time", []VestingEntry{NewVestingEntry(100, releaseTime, 40, beneficiary)}, false},
+		{"neither height nor time", []VestingEntry{NewVestingEntry(0, time.Time{}, 40, beneficiary)}, false},
+		{"negative height", []VestingEntry{NewVestingEntry(-1, time.Time{}, 40, beneficiary)}, false},
+		{"zero amount", []VestingEntry{NewVestingEntry(100, time.Time{}, 0, beneficiary)}, false},
+		{"empty beneficiary", []VestingEntry{NewVestingEntry(100, time.Time{}, 40, nil)}, false},
+		{"exceeds initial supply", []VestingEntry{NewVestingEntry(100, time.Time{}, 60, beneficiary), NewVestingEntry(200, time.Time{}, 41, beneficiary)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgIssueToken(FUNGIBLE, NATIVE, "", "btc", "", "btc", 0, "", 100, 100, false, addr)
+		msg.VestingSchedule = tc.schedule
+
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.testCase)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.testCase)
+		}
+	}
+}
+
 func TestNewMsgCreateGateway(t *testing.T) {}
 
 func TestMsgCreateGatewayRoute(t *testing.T) {
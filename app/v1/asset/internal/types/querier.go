@@ -13,6 +13,7 @@ const (
 	QueryGateway  = "gateway"
 	QueryGateways = "gateways"
 	QueryFees     = "fees"
+	QueryVestings = "vestings"
 )
 
 // QueryTokenParams is the query parameters for 'custom/asset/tokens/{id}'
@@ -47,6 +48,12 @@ type QueryTokenFeesParams struct {
 	ID string
 }
 
+// QueryVestingsParams is the query parameters for 'custom/asset/vestings'
+type QueryVestingsParams struct {
+	TokenId     string
+	Beneficiary sdk.AccAddress
+}
+
 // GatewayFeeOutput is for the gateway fee query output
 type GatewayFeeOutput struct {
 	Exist bool     `json:"exist"` // indicate if the gateway has existed
@@ -15,4 +15,6 @@ var (
 	TagOwner   = "token-owner"
 	TagGateway = "token-gateway"
 	TagSource  = "token-source"
+
+	TagBeneficiary = "vesting-beneficiary"
 )
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// MaximumVestingEntries is the maximal number of vesting entries in a token issuance
+const MaximumVestingEntries = 100
+
+// VestingEntry defines a time-locked part of the initial supply in MsgIssueToken.
+// Exactly one of ReleaseHeight and ReleaseTime must be specified
+type VestingEntry struct {
+	ReleaseHeight int64          `json:"release_height"` // the block height at which the coins are released
+	ReleaseTime   time.Time      `json:"release_time"`   // the block time at which the coins are released
+	Amount        uint64         `json:"amount"`         // the amount to be released, in the main unit of the token
+	Beneficiary   sdk.AccAddress `json:"beneficiary"`    // the account receiving the released coins
+}
+
+// NewVestingEntry constructs a VestingEntry
+func NewVestingEntry(releaseHeight int64, releaseTime time.Time, amount uint64, beneficiary sdk.AccAddress) VestingEntry {
+	return VestingEntry{
+		ReleaseHeight: releaseHeight,
+		ReleaseTime:   releaseTime,
+		Amount:        amount,
+		Beneficiary:   beneficiary,
+	}
+}
+
+// IsHeightLocked returns true if the entry is released by block height
+func (ve VestingEntry) IsHeightLocked() bool {
+	return ve.ReleaseHeight > 0
+}
+
+// Validate checks the basic validity of the vesting entry
+func (ve VestingEntry) Validate() sdk.Error {
+	if ve.ReleaseHeight < 0 {
+		return ErrInvalidVesting(DefaultCodespace, fmt.Sprintf("invalid release height %d", ve.ReleaseHeight))
+	}
+
+	if (ve.ReleaseHeight > 0) == !ve.ReleaseTime.IsZero() {
+		return ErrInvalidVesting(DefaultCodespace, "exactly one of release height and release time must be specified")
+	}
+
+	if ve.Amount == 0 {
+		return ErrInvalidVesting(DefaultCodespace, "the vesting amount must be greater than 0")
+	}
+
+	if ve.Beneficiary.Empty() {
+		return ErrInvalidVesting(DefaultCodespace, "the beneficiary of the vesting must be specified")
+	}
+
+	return nil
+}
+
+// String implements stringer
+func (ve VestingEntry) String() string {
+	release := ve.ReleaseTime.String()
+	if ve.IsHeightLocked() {
+		release = fmt.Sprintf("height %d", ve.ReleaseHeight)
+	}
+
+	return fmt.Sprintf("%d to %s at %s", ve.Amount, ve.Beneficiary, release)
+}
+
+// validateVestingSchedule checks the given schedule against the initial supply
+func validateVestingSchedule(schedule []VestingEntry, initialSupply uint64) sdk.Error {
+	if len(schedule) > MaximumVestingEntries {
+		return ErrInvalidVesting(DefaultCodespace, fmt.Sprintf("too many vesting entries, only accepts [0, %d]", MaximumVestingEntries))
+	}
+
+	var total uint64
+	for _, entry := range schedule {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		total += entry.Amount
+		if total > initialSupply || total < entry.Amount {
+			return ErrInvalidVesting(DefaultCodespace, fmt.Sprintf("the total vesting amount must not be greater than the initial supply %d", initialSupply))
+		}
+	}
+
+	return nil
+}
+
+// TokenVesting represents the locked coins of a vesting entry held by the asset module
+type TokenVesting struct {
+	TokenId       string         `json:"token_id"`       // the id of the issued token
+	Index         uint64         `json:"index"`          // the position of the entry in the issuance schedule
+	ReleaseHeight int64          `json:"release_height"` // the block height at which the coins are released
+	ReleaseTime   time.Time      `json:"release_time"`   // the block time at which the coins are released
+	Amount        sdk.Coin       `json:"amount"`         // the locked coins
+	Beneficiary   sdk.AccAddress `json:"beneficiary"`    // the account receiving the released coins
+}
+
+// NewTokenVesting constructs a TokenVesting
+func NewTokenVesting(tokenId string, index uint64, releaseHeight int64, releaseTime time.Time, amount sdk.Coin, beneficiary sdk.AccAddress) TokenVesting {
+	return TokenVesting{
+		TokenId:       tokenId,
+		Index:         index,
+		ReleaseHeight: releaseHeight,
+		ReleaseTime:   releaseTime,
+		Amount:        amount,
+		Beneficiary:   beneficiary,
+	}
+}
+
+// IsHeightLocked returns true if the vesting is released by block height
+func (tv TokenVesting) IsHeightLocked() bool {
+	return tv.ReleaseHeight > 0
+}
+
+// Validate checks the validity of the token vesting
+func (tv TokenVesting) Validate() sdk.Error {
+	if err := CheckTokenID(tv.TokenId); err != nil {
+		return err
+	}
+
+	if tv.ReleaseHeight < 0 || (tv.ReleaseHeight > 0) == !tv.ReleaseTime.IsZero() {
+		return ErrInvalidVesting(DefaultCodespace, "exactly one of release height and release time must be specified")
+	}
+
+	if !tv.Amount.IsPositive() {
+		return ErrInvalidVesting(DefaultCodespace, fmt.Sprintf("invalid vesting amount %s", tv.Amount))
+	}
+
+	if tv.Beneficiary.Empty() {
+		return ErrInvalidVesting(DefaultCodespace, "the beneficiary of the vesting must be specified")
+	}
+
+	return nil
+}
+
+// String implements stringer
+func (tv TokenVesting) String() string {
+	release := tv.ReleaseTime.String()
+	if tv.IsHeightLocked() {
+		release = fmt.Sprintf("%d", tv.ReleaseHeight)
+	}
+
+	return fmt.Sprintf(`TokenVesting:
+  TokenId:      %s
+  Index:        %d
+  Release:      %s
+  Amount:       %s
+  Beneficiary:  %s`,
+		tv.TokenId, tv.Index, release, tv.Amount.String(), tv.Beneficiary)
+}
+
+// TokenVestings is a set of token vestings
+type TokenVestings []TokenVesting
+
+// String implements stringer
+func (tvs TokenVestings) String() string {
+	if len(tvs) == 0 {
+		return "[]"
+	}
+
+	var out strings.Builder
+	for _, tv := range tvs {
+		out.WriteString(tv.String() + "\n")
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
+// Validate checks the validity of the token vestings
+func (tvs TokenVestings) Validate() sdk.Error {
+	for _, tv := range tvs {
+		if err := tv.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
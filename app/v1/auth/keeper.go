@@ -21,12 +21,15 @@ var (
 	BurnedCoinsAccAddr       = sdk.AccAddress(crypto.AddressHash([]byte("burnedCoins")))
 	GovDepositCoinsAccAddr   = sdk.AccAddress(crypto.AddressHash([]byte("govDepositedCoins")))
 	CommunityTaxCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("communityTaxCoins")))
+	GovModuleAccAddr         = sdk.AccAddress(crypto.AddressHash([]byte("govModule"))) // GovModuleAccAddr signs the msgs executed by governance
 
 	ServiceDepositCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("serviceDepositedCoins")))
 	ServiceRequestCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("serviceRequestCoins")))
 	ServiceTaxCoinsAccAddr     = sdk.AccAddress(crypto.AddressHash([]byte("serviceTaxCoins")))
 
 	HTLCLockedCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("HTLCLockedCoins"))) // HTLCLockedCoinsAccAddr store All HTLC locked coins
+
+	TokenVestingCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("tokenVestingCoins"))) // TokenVestingCoinsAccAddr store all time-locked token issuance coins
 )
 
 // This AccountKeeper encodes/decodes accounts using the
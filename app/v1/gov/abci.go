@@ -49,12 +49,16 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		result, tallyResults, votingVals := tally(ctx, keeper, activeProposal)
 
 		var action []byte
+		executionResult := ExecutionNone
 		if result == PASS {
 			keeper.metrics.SetProposalStatus(proposalID, StatusPassed)
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
 			activeProposal.SetStatus(StatusPassed)
 			action = tags.ActionProposalPassed
-			activeProposal.Execute(ctx, keeper)
+			executionResult = ExecutionSuccess
+			if err := activeProposal.Execute(ctx, keeper); err != nil {
+				executionResult = err.ABCILog()
+			}
 		} else if result == REJECT {
 			keeper.metrics.SetProposalStatus(proposalID, StatusRejected)
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
@@ -69,6 +73,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.GetVotingEndTime(), activeProposal.GetProposalID())
 		activeProposal.SetTallyResult(tallyResults)
 		keeper.SetProposal(ctx, activeProposal)
+		keeper.SetProposalRecord(ctx, NewProposalRecord(activeProposal, getValidatorVotes(ctx, keeper, proposalID, votingVals), executionResult))
 		ctx.Logger().Info("Proposal tallied", "ProposalID", activeProposal.GetProposalID(), "result", result, "tallyResults", tallyResults)
 		resTags = resTags.AppendTag(tags.Action, action)
 		resTags = resTags.AppendTag(tags.ProposalID, []byte(strconv.FormatUint(proposalID, 10)))
@@ -93,3 +98,17 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 	}
 	return resTags
 }
+
+// getValidatorVotes returns the votes of the validators used in the tally, in the order of the votes store
+func getValidatorVotes(ctx sdk.Context, keeper Keeper, proposalID uint64, votingVals map[string]bool) (votes []Vote) {
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	defer votesIterator.Close()
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		var vote Vote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
+		if votingVals[sdk.ValAddress(vote.Voter).String()] {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
@@ -9,9 +9,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "irishub/gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgSubmitCommunityTaxUsageProposal{}, "irishub/gov/MsgSubmitCommunityTaxUsageProposal", nil)
 	cdc.RegisterConcrete(MsgSubmitSoftwareUpgradeProposal{}, "irishub/gov/MsgSubmitSoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitUpgradeRescheduleProposal{}, "irishub/gov/MsgSubmitUpgradeRescheduleProposal", nil)
 	cdc.RegisterConcrete(MsgSubmitTokenAdditionProposal{}, "irishub/gov/MsgSubmitTokenAdditionProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitMsgsProposal{}, "irishub/gov/MsgSubmitMsgsProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "irishub/gov/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "irishub/gov/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(MsgSetRepresentative{}, "irishub/gov/MsgSetRepresentative", nil)
 	cdc.RegisterConcrete(MsgVote{}, "irishub/gov/MsgVote", nil)
+	cdc.RegisterConcrete(MsgWeightedVote{}, "irishub/gov/MsgWeightedVote", nil)
 
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&BasicProposal{}, "irishub/gov/BasicProposal", nil)
@@ -19,8 +24,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&PlainTextProposal{}, "irishub/gov/PlainTextProposal", nil)
 	cdc.RegisterConcrete(&TokenAdditionProposal{}, "irishub/gov/TokenAdditionProposal", nil)
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "irishub/gov/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(&UpgradeRescheduleProposal{}, "irishub/gov/UpgradeRescheduleProposal", nil)
 	cdc.RegisterConcrete(&SystemHaltProposal{}, "irishub/gov/SystemHaltProposal", nil)
 	cdc.RegisterConcrete(&CommunityTaxUsageProposal{}, "irishub/gov/CommunityTaxUsageProposal", nil)
+	cdc.RegisterConcrete(&MsgsProposal{}, "irishub/gov/MsgsProposal", nil)
 	cdc.RegisterConcrete(&Vote{}, "irishub/gov/Vote", nil)
 	cdc.RegisterConcrete(&GovParams{}, "irishub/gov/Params", nil)
 }
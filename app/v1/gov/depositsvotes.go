@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/pkg/errors"
@@ -10,29 +11,56 @@ import (
 
 // Vote
 type Vote struct {
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Voter      sdk.AccAddress      `json:"voter"`             //  address of the voter
+	ProposalID uint64              `json:"proposal_id"`       //  proposalID of the proposal
+	Option     VoteOption          `json:"option"`            //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty"` // options with weights of a weighted vote, Option is empty then
 }
 
 func (v Vote) String() string {
+	if len(v.Options) > 0 {
+		return fmt.Sprintf("Voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("Voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
+// WeightedOptions returns the options with weights of the vote, a single option has the weight 1
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) > 0 {
+		return v.Options
+	}
+	return WeightedVoteOptions{{Option: v.Option, Weight: sdk.OneDec()}}
+}
+
 // Votes is a collection of Vote
 type Votes []Vote
 
 func (v Votes) String() string {
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.WeightedOptions())
+	}
+	return out
+}
+
+// Delegators represented by a governance representative
+type RepresentedDelegators []sdk.AccAddress
+
+func (d RepresentedDelegators) String() string {
+	if len(d) == 0 {
+		return "[]"
+	}
+	out := "Represented Delegators:"
+	for _, delegator := range d {
+		out += fmt.Sprintf("\n  %s", delegator)
 	}
 	return out
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option &&
+		voteA.Options.Equals(voteB.Options)
 }
 
 // Returns whether a vote is empty
@@ -156,6 +184,11 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return nil
 	}
+	// the option of a weighted vote is empty
+	if len(s) == 0 {
+		*vo = OptionEmpty
+		return nil
+	}
 
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
@@ -191,3 +224,88 @@ func (vo VoteOption) Format(s fmt.State, verb rune) {
 		s.Write([]byte(fmt.Sprintf("%v", byte(vo))))
 	}
 }
+
+// WeightedVoteOption defines an option with the fraction of the voting power credited to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption
+type WeightedVoteOptions []WeightedVoteOption
+
+// WeightedVoteOptionsFromString parses options with weights in the form of Yes=0.6,No=0.4
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		kv := strings.Split(strings.TrimSpace(pair), "=")
+		if len(kv) != 2 {
+			return nil, errors.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+		option, err := VoteOptionFromString(kv[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(kv[1])
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, WeightedVoteOption{option, weight})
+	}
+	return options, nil
+}
+
+// Is defined options with positive weights summing to 1
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+	total := sdk.ZeroDec()
+	for i, option := range options {
+		if !ValidVoteOption(option.Option) {
+			return false
+		}
+		if option.Weight.Int == nil || !option.Weight.IsPositive() {
+			return false
+		}
+		for _, other := range options[:i] {
+			if other.Option == option.Option {
+				return false
+			}
+		}
+		total = total.Add(option.Weight)
+	}
+	return total.Equal(sdk.OneDec())
+}
+
+// Returns whether 2 options with weights are equal
+func (w WeightedVoteOptions) Equals(other WeightedVoteOptions) bool {
+	if len(w) != len(other) {
+		return false
+	}
+	for i := range w {
+		if w[i].Option != other[i].Option || !w[i].Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// major returns the option with the largest weight
+func (w WeightedVoteOptions) major() VoteOption {
+	major := WeightedVoteOption{Option: OptionEmpty, Weight: sdk.ZeroDec()}
+	for _, option := range w {
+		if option.Weight.GT(major.Weight) {
+			major = option
+		}
+	}
+	return major.Option
+}
+
+func (w WeightedVoteOptions) String() string {
+	options := make([]string, len(w))
+	for i, option := range w {
+		options[i] = fmt.Sprintf("%s=%s", option.Option, option.Weight.String())
+	}
+	return strings.Join(options, ",")
+}
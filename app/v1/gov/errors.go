@@ -40,6 +40,11 @@ const (
 	CodeInvalidUpgradeParams         sdk.CodeType = 28
 	CodeEmptyParam                   sdk.CodeType = 29
 	CodeInvalidParamNum              sdk.CodeType = 30
+	CodeInvalidProposalMsg           sdk.CodeType = 31
+	CodeNotProposer                  sdk.CodeType = 32
+	CodeInvalidRepresentative        sdk.CodeType = 33
+	CodeRepresentativeNotExisted     sdk.CodeType = 34
+	CodeNoSwitchPeriodInProcess      sdk.CodeType = 35
 )
 
 //----------------------------------------
@@ -81,6 +86,10 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' is not valid weighted vote options, weights must be positive and sum to 1", options))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
@@ -104,6 +113,10 @@ func ErrSwitchPeriodInProcess(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSwitchPeriodInProcess, fmt.Sprintf("Software Upgrade Switch Period is in process."))
 }
 
+func ErrNoSwitchPeriodInProcess(codespace sdk.CodespaceType, version uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSwitchPeriodInProcess, fmt.Sprintf("Software Upgrade Switch Period of version [%d] is not in process.", version))
+}
+
 func ErrInvalidPercent(codespace sdk.CodespaceType, percent sdk.Dec) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidPercent, fmt.Sprintf("invalid percent [%s], must be greater than 0 and less than or equal to 1", percent.String()))
 }
@@ -148,7 +161,7 @@ func ErrAlreadyVote(codespace sdk.CodespaceType, address sdk.AccAddress, proposa
 }
 
 func ErrOnlyValidatorOrDelegatorVote(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
-	return sdk.NewError(codespace, CodeOnlyValidatorOrDelegatorVote, fmt.Sprintf("Address %s is neither a validator nor a delegator, so can't vote.", address))
+	return sdk.NewError(codespace, CodeOnlyValidatorOrDelegatorVote, fmt.Sprintf("Address %s is neither a validator, a delegator nor a representative, so can't vote.", address))
 }
 
 func ErrMoreThanMaxProposal(codespace sdk.CodespaceType, num uint64, proposalLevel string) sdk.Error {
@@ -162,3 +175,19 @@ func ErrInvalidUpgradeThreshold(codespace sdk.CodespaceType, Threshold sdk.Dec)
 func ErrNotEnoughInitialDeposit(codespace sdk.CodespaceType, initialDeposit sdk.Coins, minDeposit sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeNotEnoughInitialDeposit, fmt.Sprintf("Initial Deposit [%s] is less than minInitialDeposit [%s]", initialDeposit.String(), minDeposit.String()))
 }
+
+func ErrInvalidProposalMsg(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalMsg, msg)
+}
+
+func ErrNotProposer(codespace sdk.CodespaceType, address sdk.AccAddress, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotProposer, fmt.Sprintf("Address %s isn't the proposer of the proposal [%d]", address, proposalID))
+}
+
+func ErrInvalidRepresentative(codespace sdk.CodespaceType, representative sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRepresentative, fmt.Sprintf("Address %s can't be the representative of itself", representative))
+}
+
+func ErrRepresentativeNotExisted(codespace sdk.CodespaceType, delegator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeRepresentativeNotExisted, fmt.Sprintf("Address %s hasn't set a representative", delegator))
+}
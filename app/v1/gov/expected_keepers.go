@@ -10,3 +10,8 @@ type AssetKeeper interface {
 	IssueToken(ctx sdk.Context, token exported.FungibleToken) (sdk.Tags, sdk.Error)
 	HasToken(ctx sdk.Context, tokenId string) bool
 }
+
+// UpgradeKeeper expected upgrade keeper
+type UpgradeKeeper interface {
+	CancelUpgrade(ctx sdk.Context, upgradeConfig sdk.UpgradeConfig)
+}
@@ -26,6 +26,7 @@ func DefaultGenesisState() GenesisState {
 
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	data.Params = defaultMissingParams(data.Params)
 	err := ValidateGenesis(data)
 	if err != nil {
 		// TODO: Handle this with #870
@@ -51,13 +52,21 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 }
 
 func ValidateGenesis(data GenesisState) error {
-	err := validateParams(data.Params)
+	err := validateParams(defaultMissingParams(data.Params))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// the params added later are missing in the genesis exported before, the defaults are used for them
+func defaultMissingParams(params GovParams) GovParams {
+	if params.CancelBurnRate.IsNil() {
+		params.CancelBurnRate = DefaultParams().CancelBurnRate
+	}
+	return params
+}
+
 // get raw genesis raw message for testing
 func DefaultGenesisStateForCliTest() GenesisState {
 
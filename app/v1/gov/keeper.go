@@ -13,6 +13,7 @@ import (
 	"github.com/irisnet/irishub/modules/guardian"
 	sdk "github.com/irisnet/irishub/types"
 
+	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/params"
 	"strconv"
 )
@@ -56,6 +57,11 @@ type Keeper struct {
 	metrics *Metrics
 
 	ak AssetKeeper
+
+	// The router to execute the msgs of a MsgsProposal
+	router protocol.Router
+
+	uk UpgradeKeeper
 }
 
 // NewProtocolKeeper returns a governance keeper. It handles:
@@ -63,7 +69,7 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
-func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, paramsKeeper params.Keeper, protocolKeeper sdk.ProtocolKeeper, ck bank.Keeper, dk distribution.Keeper, guardianKeeper guardian.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, metrics *Metrics, ak AssetKeeper) Keeper {
+func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, paramsKeeper params.Keeper, protocolKeeper sdk.ProtocolKeeper, ck bank.Keeper, dk distribution.Keeper, guardianKeeper guardian.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, metrics *Metrics, ak AssetKeeper, router protocol.Router, uk UpgradeKeeper) Keeper {
 	return Keeper{
 		key,
 		cdc,
@@ -78,6 +84,8 @@ func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, p
 		codespace,
 		metrics,
 		ak,
+		router,
+		uk,
 	}
 }
 
@@ -185,6 +193,70 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(KeyProposal(proposalID))
 }
 
+// Cancels a proposal in the deposit period by the proposer, the deposits are refunded except the CancelBurnRate fraction
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.GetProposer().Equals(proposer) {
+		return ErrNotProposer(keeper.codespace, proposer, proposalID)
+	}
+	if proposal.GetStatus() != StatusDepositPeriod {
+		return ErrNotInDepositPeriod(keeper.codespace, proposalID)
+	}
+
+	keeper.refundCancelledDeposits(ctx, proposalID, keeper.GetCancelBurnRate(ctx))
+	keeper.SubProposalNum(ctx, proposal.GetProposalLevel())
+	keeper.DeleteProposal(ctx, proposalID)
+	keeper.metrics.DeleteProposalStatus(proposalID)
+	return nil
+}
+
+// Persists the final record of a proposal at the end of its voting period
+func (keeper Keeper) SetProposalRecord(ctx sdk.Context, record ProposalRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(KeyProposalRecord(record.ProposalID), bz)
+}
+
+// Gets the final record of a proposal
+func (keeper Keeper) GetProposalRecord(ctx sdk.Context, proposalID uint64) (record ProposalRecord, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyProposalRecord(proposalID))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// Gets a page of the proposal records in the ascending order of the proposal id, filtered by status and type if valid
+func (keeper Keeper) GetProposalRecordsFiltered(ctx sdk.Context, status ProposalStatus, proposalType ProposalKind, page uint64, size uint16) ProposalRecords {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), PrefixProposalRecord)
+	defer iterator.Close()
+
+	skip := sdk.GetSkipCount(page, size)
+	records := make(ProposalRecords, 0, size)
+	for i := 0; iterator.Valid() && i < int(skip)+int(size); iterator.Next() {
+		var record ProposalRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+
+		if ValidProposalStatus(status) && record.Status != status {
+			continue
+		}
+		if ValidProposalType(proposalType) && record.ProposalType != proposalType {
+			continue
+		}
+
+		if i >= int(skip) {
+			records = append(records, record)
+		}
+		i++
+	}
+	return records
+}
+
 // Get Proposal from store by ProposalID
 func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddress, depositorAddr sdk.AccAddress, status ProposalStatus, numLatest uint64) []Proposal {
 
@@ -292,6 +364,35 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 
 // Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	if !ValidVoteOption(option) {
+		return ErrInvalidVote(keeper.codespace, option)
+	}
+
+	vote := Vote{
+		ProposalID: proposalID,
+		Voter:      voterAddr,
+		Option:     option,
+	}
+	return keeper.addVote(ctx, vote)
+}
+
+// Adds a vote splitting the voting power by weights on a specific proposal
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
+	if !ValidWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
+	}
+
+	vote := Vote{
+		ProposalID: proposalID,
+		Voter:      voterAddr,
+		Option:     OptionEmpty,
+		Options:    options,
+	}
+	return keeper.addVote(ctx, vote)
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote Vote) sdk.Error {
+	proposalID, voterAddr := vote.ProposalID, vote.Voter
 	proposal := keeper.GetProposal(ctx, proposalID)
 	if proposal == nil {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -307,7 +408,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			isDelegator = true
 			return isDelegator
 		})
-		if !isDelegator {
+		if !isDelegator && !keeper.isRepresentative(ctx, voterAddr) {
 			return ErrOnlyValidatorOrDelegatorVote(keeper.codespace, voterAddr)
 		}
 	}
@@ -316,22 +417,78 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrAlreadyVote(keeper.codespace, voterAddr, proposalID)
 	}
 
-	if !ValidVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	keeper.setVote(ctx, proposalID, voterAddr, vote)
+	if validator != nil {
+		keeper.metrics.AddVote(validator.GetOperator().String(), proposalID, vote.WeightedOptions().major())
 	}
+	return nil
+}
 
-	vote := Vote{
-		ProposalID: proposalID,
-		Voter:      voterAddr,
-		Option:     option,
+// =====================================================
+// Representatives
+
+// Sets the governance representative of a delegator, an empty representative removes the current one
+func (keeper Keeper) SetRepresentative(ctx sdk.Context, delegator, representative sdk.AccAddress) sdk.Error {
+	if delegator.Equals(representative) {
+		return ErrInvalidRepresentative(keeper.codespace, representative)
 	}
-	keeper.setVote(ctx, proposalID, voterAddr, vote)
-	if validator != nil {
-		keeper.metrics.AddVote(validator.GetOperator().String(), proposalID, option)
+
+	store := ctx.KVStore(keeper.storeKey)
+	if current, found := keeper.GetRepresentative(ctx, delegator); found {
+		store.Delete(KeyRepresentedDelegator(current, delegator))
+		store.Delete(KeyRepresentative(delegator))
 	}
+	if len(representative) == 0 {
+		return nil
+	}
+
+	store.Set(KeyRepresentative(delegator), keeper.cdc.MustMarshalBinaryLengthPrefixed(representative))
+	store.Set(KeyRepresentedDelegator(representative, delegator), []byte{})
 	return nil
 }
 
+// Gets the governance representative of a delegator
+func (keeper Keeper) GetRepresentative(ctx sdk.Context, delegator sdk.AccAddress) (representative sdk.AccAddress, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyRepresentative(delegator))
+	if bz == nil {
+		return nil, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &representative)
+	return representative, true
+}
+
+// Iterates over the delegators represented by a representative
+func (keeper Keeper) IterateRepresentedDelegators(ctx sdk.Context, representative sdk.AccAddress, fn func(delegator sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := KeyRepresentedDelegatorsSubspace(representative)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(sdk.AccAddress(iterator.Key()[len(prefix):])) {
+			break
+		}
+	}
+}
+
+// Gets the delegators represented by a representative
+func (keeper Keeper) GetRepresentedDelegators(ctx sdk.Context, representative sdk.AccAddress) (delegators RepresentedDelegators) {
+	keeper.IterateRepresentedDelegators(ctx, representative, func(delegator sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, delegator)
+		return false
+	})
+	return delegators
+}
+
+func (keeper Keeper) isRepresentative(ctx sdk.Context, addr sdk.AccAddress) (represents bool) {
+	keeper.IterateRepresentedDelegators(ctx, addr, func(delegator sdk.AccAddress) (stop bool) {
+		represents = true
+		return true
+	})
+	return represents
+}
+
 // Gets the vote of a specific voter on a specific proposal
 func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (Vote, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -476,6 +633,39 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 
 }
 
+// Refunds and deletes all the deposits on a cancelled proposal, burning the given fraction of each deposit
+func (keeper Keeper) refundCancelledDeposits(ctx sdk.Context, proposalID uint64, burnRate sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+	depositsIterator := keeper.GetDeposits(ctx, proposalID)
+	defer depositsIterator.Close()
+
+	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
+
+		amount := deposit.Amount.AmountOf(stakeTypes.StakeDenom)
+		burnAmount := sdk.NewDecFromInt(amount).Mul(burnRate).TruncateInt()
+		refundCoins := sdk.Coins{sdk.NewCoin(stakeTypes.StakeDenom, amount.Sub(burnAmount))}
+		burnCoins := sdk.Coins{sdk.NewCoin(stakeTypes.StakeDenom, burnAmount)}
+
+		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), deposit.Depositor.String(), refundCoins.String(), sdk.GovDepositRefundFlow, "")
+		_, err := keeper.ck.SendCoins(ctx, auth.GovDepositCoinsAccAddr, deposit.Depositor, refundCoins)
+		if err != nil {
+			panic(err)
+		}
+
+		if burnAmount.IsPositive() {
+			ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), "", burnCoins.String(), sdk.GovDepositBurnFlow, "")
+			_, err = keeper.ck.BurnCoins(ctx, auth.GovDepositCoinsAccAddr, burnCoins)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(depositsIterator.Key())
+	}
+}
+
 // Deletes all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -675,10 +865,18 @@ func (keeper Keeper) GetSystemHaltPeriod(ctx sdk.Context) (SystemHaltPeriod int6
 	return
 }
 
+func (keeper Keeper) GetCancelBurnRate(ctx sdk.Context) (cancelBurnRate sdk.Dec) {
+	// the parameter is not stored on the chains started before it was added
+	cancelBurnRate = DefaultParams().CancelBurnRate
+	keeper.paramSpace.GetIfExists(ctx, KeyCancelBurnRate, &cancelBurnRate)
+	return
+}
+
 // get inflation params from the global param store
 func (keeper Keeper) GetParamSet(ctx sdk.Context) GovParams {
-	var params GovParams
-	keeper.paramSpace.GetParamSet(ctx, &params)
+	// the params added after the chain started are not stored, the defaults are used for them
+	params := DefaultParams()
+	keeper.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -17,6 +17,7 @@ var (
 	KeyNextProposalID           = []byte("newProposalID")
 	PrefixActiveProposalQueue   = []byte("activeProposalQueue")
 	PrefixInactiveProposalQueue = []byte("inactiveProposalQueue")
+	PrefixProposalRecord        = []byte("proposalRecords")
 )
 
 // Key for getting a specific proposal from the store
@@ -24,6 +25,14 @@ func KeyProposal(proposalID uint64) []byte {
 	return []byte(fmt.Sprintf("proposals:%d", proposalID))
 }
 
+// Key for getting the final record of a specific proposal from the store
+func KeyProposalRecord(proposalID uint64) []byte {
+	return bytes.Join([][]byte{
+		PrefixProposalRecord,
+		sdk.Uint64ToBigEndian(proposalID),
+	}, KeyDelimiter)
+}
+
 // Key for getting a specific deposit from the store
 func KeyDeposit(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("deposits:%d:%d", proposalID, depositorAddr))
@@ -85,8 +94,26 @@ var (
 	KeyImportantProposalNum = []byte("ImportantProposalNum")
 	KeyNormalProposalNum    = []byte("NormalProposalNum")
 	PrefixValidatorSet      = []byte("vs")
+
+	PrefixRepresentative        = []byte("representatives")
+	PrefixRepresentedDelegators = []byte("representedDelegators")
 )
 
 func KeyValidatorSet(proposalID uint64) []byte {
 	return bytes.Join([][]byte{PrefixValidatorSet, sdk.Uint64ToBigEndian(proposalID)}, KeyDelimiter)
 }
+
+// Key for getting the governance representative of a delegator
+func KeyRepresentative(delegator sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{PrefixRepresentative, delegator}, KeyDelimiter)
+}
+
+// Key for indexing a delegator by its governance representative
+func KeyRepresentedDelegator(representative, delegator sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{PrefixRepresentedDelegators, representative, delegator}, KeyDelimiter)
+}
+
+// Key for getting all the delegators represented by a representative
+func KeyRepresentedDelegatorsSubspace(representative sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{PrefixRepresentedDelegators, representative, {}}, KeyDelimiter)
+}
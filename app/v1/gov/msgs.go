@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/irisnet/irishub/app/v1/asset/exported"
 
@@ -10,7 +11,7 @@ import (
 // name to idetify transaction types
 const MsgRoute = "gov"
 
-var _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitCommunityTaxUsageProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitCommunityTaxUsageProposal{}, MsgDeposit{}, MsgVote{}, MsgWeightedVote{}, MsgCancelProposal{}, MsgSetRepresentative{}
 
 type Content interface {
 	sdk.Msg
@@ -165,6 +166,55 @@ func (msg MsgSubmitSoftwareUpgradeProposal) GetSignBytes() []byte {
 	return sdk.MustSortJSON(b)
 }
 
+type MsgSubmitUpgradeRescheduleProposal struct {
+	MsgSubmitProposal
+	Version      uint64  `json:"version"`       // version of the upgrade in progress
+	Cancel       bool    `json:"cancel"`        // clear the upgrade in progress
+	SwitchHeight uint64  `json:"switch_height"` // new switch height, 0 to keep the current one
+	Threshold    sdk.Dec `json:"threshold"`     // new signal threshold, nil or 0 to keep the current one
+}
+
+func NewMsgSubmitUpgradeRescheduleProposal(msgSubmitProposal MsgSubmitProposal, version uint64, cancel bool, switchHeight uint64, threshold sdk.Dec) MsgSubmitUpgradeRescheduleProposal {
+	return MsgSubmitUpgradeRescheduleProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		Version:           version,
+		Cancel:            cancel,
+		SwitchHeight:      switchHeight,
+		Threshold:         threshold,
+	}
+}
+
+func (msg MsgSubmitUpgradeRescheduleProposal) ValidateBasic() sdk.Error {
+	err := msg.MsgSubmitProposal.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	if msg.Cancel {
+		return nil
+	}
+
+	keepThreshold := msg.Threshold.IsNil() || msg.Threshold.IsZero()
+	if msg.SwitchHeight == 0 && keepThreshold {
+		return sdk.NewError(DefaultCodespace, CodeInvalidProposal, "either the switch height or the threshold must be rescheduled if not cancelled")
+	}
+
+	// if threshold not in [0.8,1), then print error
+	if !keepThreshold && (msg.Threshold.LT(sdk.NewDecWithPrec(80, 2)) || msg.Threshold.GTE(sdk.NewDec(1))) {
+		return ErrInvalidUpgradeThreshold(DefaultCodespace, msg.Threshold)
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitUpgradeRescheduleProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
 type MsgSubmitCommunityTaxUsageProposal struct {
 	MsgSubmitProposal
 	Usage       UsageType      `json:"usage"`
@@ -322,6 +372,149 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
 
+//-----------------------------------------------------------
+// MsgWeightedVote
+type MsgWeightedVote struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  options with weights summing to 1
+}
+
+func NewMsgWeightedVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgWeightedVote {
+	return MsgWeightedVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgWeightedVote) Route() string { return MsgRoute }
+func (msg MsgWeightedVote) Type() string  { return "weighted_vote" }
+
+// Implements Msg.
+func (msg MsgWeightedVote) ValidateBasic() sdk.Error {
+	if len(msg.Voter.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+	return nil
+}
+
+func (msg MsgWeightedVote) String() string {
+	return fmt.Sprintf("MsgWeightedVote{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+//-----------------------------------------------------------
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    // Address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return MsgRoute }
+func (msg MsgCancelProposal) Type() string  { return "cancel_proposal" }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf("MsgCancelProposal{%s=>%v}", msg.Proposer, msg.ProposalID)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+//-----------------------------------------------------------
+// MsgSetRepresentative
+type MsgSetRepresentative struct {
+	Delegator      sdk.AccAddress `json:"delegator"`      // Address of the delegator
+	Representative sdk.AccAddress `json:"representative"` // Address of the representative, empty to remove the current one
+}
+
+func NewMsgSetRepresentative(delegator, representative sdk.AccAddress) MsgSetRepresentative {
+	return MsgSetRepresentative{
+		Delegator:      delegator,
+		Representative: representative,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgSetRepresentative) Route() string { return MsgRoute }
+func (msg MsgSetRepresentative) Type() string  { return "set_representative" }
+
+// Implements Msg.
+func (msg MsgSetRepresentative) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) == 0 {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.Delegator.Equals(msg.Representative) {
+		return ErrInvalidRepresentative(DefaultCodespace, msg.Representative)
+	}
+	return nil
+}
+
+func (msg MsgSetRepresentative) String() string {
+	return fmt.Sprintf("MsgSetRepresentative{%s=>%s}", msg.Delegator, msg.Representative)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
 func (msg MsgSubmitProposal) EnsureLength() sdk.Error {
 	if len(msg.Title) > 70 {
 		return sdk.ErrInvalidLength(DefaultCodespace, CodeInvalidProposal, "title", len(msg.Title), 70)
@@ -369,3 +562,46 @@ func (msg MsgSubmitTokenAdditionProposal) GetSignBytes() []byte {
 	}
 	return sdk.MustSortJSON(b)
 }
+
+type MsgSubmitMsgsProposal struct {
+	MsgSubmitProposal
+	Msgs []sdk.Msg `json:"msgs"` // msgs executed by the governance account when the proposal passes
+}
+
+func NewMsgSubmitMsgsProposal(msgSubmitProposal MsgSubmitProposal, msgs []sdk.Msg) MsgSubmitMsgsProposal {
+	return MsgSubmitMsgsProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		Msgs:              msgs,
+	}
+}
+
+func (msg MsgSubmitMsgsProposal) ValidateBasic() sdk.Error {
+	err := msg.MsgSubmitProposal.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if msg.ProposalType != ProposalTypeMsgs {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	return validateProposalMsgs(msg.Msgs)
+}
+
+// GetSignBytes embeds the sign bytes of the proposal msgs, as they are unknown to the gov codec
+func (msg MsgSubmitMsgsProposal) GetSignBytes() []byte {
+	bz, err := msgCdc.MarshalJSON(msg.MsgSubmitProposal)
+	if err != nil {
+		panic(err)
+	}
+	msgsBytes := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgsBytes[i] = json.RawMessage(m.GetSignBytes())
+	}
+	b, err := json.Marshal(struct {
+		MsgSubmitProposal json.RawMessage   `json:"msg_submit_proposal"`
+		Msgs              []json.RawMessage `json:"msgs"`
+	}{bz, msgsBytes})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
@@ -63,6 +63,7 @@ var (
 	KeyNormalPenalty       = []byte(NORMAL + "Penalty")
 
 	KeySystemHaltPeriod = []byte("SystemHaltPeriod")
+	KeyCancelBurnRate   = []byte("CancelBurnRate")
 )
 
 // ParamTable for mint module
@@ -99,12 +100,14 @@ type GovParams struct {
 	NormalParticipation sdk.Dec       `json:"normal_participation"` //
 	NormalPenalty       sdk.Dec       `json:"normal_penalty"`       //  Penalty if validator does not vote
 
-	SystemHaltPeriod int64 `json:"system_halt_period"`
+	SystemHaltPeriod int64   `json:"system_halt_period"`
+	CancelBurnRate   sdk.Dec `json:"cancel_burn_rate"` //  Fraction of the deposits burned when a proposal is cancelled by the proposer
 }
 
 func (p GovParams) String() string {
 	return fmt.Sprintf(`Gov Params:
 System Halt Period:     %v
+Cancel Burn Rate:       %s
 Proposal Parameter:    [Critical]         [Important]        [Normal]
   DepositPeriod:        %v         %v        %v
   MinDeposit:           %s         %s        %s
@@ -114,7 +117,7 @@ Proposal Parameter:    [Critical]         [Important]        [Normal]
   Veto:                 %s         %s        %s
   Participation:        %s         %s        %s
   Penalty:              %s         %s        %s
-`, p.SystemHaltPeriod,
+`, p.SystemHaltPeriod, p.CancelBurnRate.String(),
 		p.CriticalDepositPeriod, p.ImportantDepositPeriod, p.NormalDepositPeriod,
 		p.CriticalMinDeposit.String(), p.ImportantMinDeposit.String(), p.NormalMinDeposit.String(),
 		p.CriticalVotingPeriod, p.ImportantVotingPeriod, p.NormalVotingPeriod,
@@ -160,6 +163,7 @@ func (p *GovParams) KeyValuePairs() params.KeyValuePairs {
 		{KeyNormalPenalty, &p.NormalPenalty},
 
 		{KeySystemHaltPeriod, &p.SystemHaltPeriod},
+		{KeyCancelBurnRate, &p.CancelBurnRate},
 	}
 }
 
@@ -247,6 +251,9 @@ func (p *GovParams) StringFromBytes(cdc *codec.Codec, key string, bytes []byte)
 	case string(KeySystemHaltPeriod):
 		err := cdc.UnmarshalJSON(bytes, &p.SystemHaltPeriod)
 		return strconv.FormatInt(p.SystemHaltPeriod, 10), err
+	case string(KeyCancelBurnRate):
+		err := cdc.UnmarshalJSON(bytes, &p.CancelBurnRate)
+		return p.CancelBurnRate.String(), err
 	default:
 		return "", fmt.Errorf("%s is not existed", key)
 	}
@@ -291,6 +298,7 @@ func DefaultParams() GovParams {
 			NormalParticipation: sdk.NewDecWithPrec(50, 2),
 			NormalPenalty:       sdk.ZeroDec(),
 			SystemHaltPeriod:    20000,
+			CancelBurnRate:      sdk.NewDecWithPrec(2, 1),
 		}
 	} else {
 		return GovParams{
@@ -321,6 +329,7 @@ func DefaultParams() GovParams {
 			NormalParticipation: sdk.NewDecWithPrec(50, 2),
 			NormalPenalty:       sdk.ZeroDec(),
 			SystemHaltPeriod:    60,
+			CancelBurnRate:      sdk.NewDecWithPrec(2, 1),
 		}
 	}
 }
@@ -358,6 +367,7 @@ func DefaultParamsForTest() GovParams {
 		NormalParticipation: sdk.NewDecWithPrec(75, 2),
 		NormalPenalty:       sdk.ZeroDec(),
 		SystemHaltPeriod:    60,
+		CancelBurnRate:      sdk.ZeroDec(),
 	}
 }
 
@@ -436,6 +446,10 @@ func validateParams(p GovParams) sdk.Error {
 		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSystemHaltPeriod, fmt.Sprintf("SystemHaltPeriod should be between [0, 50000]"))
 	}
 
+	if p.CancelBurnRate.IsNil() || p.CancelBurnRate.LT(sdk.ZeroDec()) || p.CancelBurnRate.GT(sdk.OneDec()) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidCancelBurnRate, fmt.Sprintf("CancelBurnRate should be between [0, 1]"))
+	}
+
 	return nil
 }
 
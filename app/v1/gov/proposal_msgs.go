@@ -0,0 +1,106 @@
+package gov
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// msg types which can be executed by a MsgsProposal, in the form of route/type
+var proposalMsgAllowList = []string{
+	"bank/send",
+	"asset/transfer_gateway_owner",
+	"asset/transfer_token_owner",
+	"service/bind_service",
+	"service/update_service_binding",
+	"service/refund_service_deposit",
+}
+
+var _ Proposal = (*MsgsProposal)(nil)
+
+// MsgsProposal executes a list of msgs signed by the governance module account when passed
+type MsgsProposal struct {
+	BasicProposal
+	Msgs []sdk.Msg `json:"msgs"`
+}
+
+func (mp MsgsProposal) HumanString(converter sdk.CoinsConverter) string {
+	bps := mp.BasicProposal.HumanString(converter)
+	msgs := make([]string, len(mp.Msgs))
+	for i, msg := range mp.Msgs {
+		msgs[i] = fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+	}
+	return fmt.Sprintf(`%s
+  Msgs:               %s`,
+		bps, strings.Join(msgs, ", "))
+}
+
+func (mp *MsgsProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Error {
+	if err := mp.BasicProposal.Validate(ctx, k, verify); err != nil {
+		return err
+	}
+	if err := validateProposalMsgs(mp.Msgs); err != nil {
+		return err
+	}
+	for _, msg := range mp.Msgs {
+		if k.router.Route(msg.Route()) == nil {
+			return ErrInvalidProposalMsg(k.codespace, fmt.Sprintf("no handler for the route %s", msg.Route()))
+		}
+	}
+	return nil
+}
+
+// Execute runs all the msgs atomically, none of them takes effect if any one fails
+func (mp *MsgsProposal) Execute(ctx sdk.Context, gk Keeper) sdk.Error {
+	logger := ctx.Logger()
+
+	if err := mp.Validate(ctx, gk, false); err != nil {
+		logger.Error("Execute MsgsProposal failed", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "err", err.Error())
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range mp.Msgs {
+		result := gk.router.Route(msg.Route())(cacheCtx, msg)
+		if !result.IsOK() {
+			logger.Error("Execute MsgsProposal failed", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "msgIndex", i, "err", result.Log)
+			return sdk.NewError(result.Codespace, result.Code, result.Log)
+		}
+	}
+	writeCache()
+
+	logger.Info("Execute MsgsProposal success", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "msgs", len(mp.Msgs))
+	return nil
+}
+
+func validateProposalMsgs(msgs []sdk.Msg) sdk.Error {
+	if len(msgs) == 0 {
+		return ErrInvalidProposalMsg(DefaultCodespace, "msgs of the proposal can not be empty")
+	}
+	for _, msg := range msgs {
+		if !allowedProposalMsg(msg) {
+			return ErrInvalidProposalMsg(DefaultCodespace, fmt.Sprintf("msg %s/%s is not allowed in a proposal", msg.Route(), msg.Type()))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(auth.GovModuleAccAddr) {
+				return ErrInvalidProposalMsg(DefaultCodespace, fmt.Sprintf("msg %s/%s must be signed by the governance account %s", msg.Route(), msg.Type(), auth.GovModuleAccAddr))
+			}
+		}
+	}
+	return nil
+}
+
+func allowedProposalMsg(msg sdk.Msg) bool {
+	msgType := fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+	for _, allowed := range proposalMsgAllowList {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package gov
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	ExecutionNone    = ""        // the proposal is not executed as it isn't passed
+	ExecutionSuccess = "success" // the proposal is executed successfully
+)
+
+// ProposalRecord is the final and immutable record of a proposal persisted at the end of its voting period.
+// The proposals dropped or cancelled in the deposit period never enter the voting period, so they have no records
+type ProposalRecord struct {
+	ProposalID   uint64         `json:"proposal_id"`
+	Title        string         `json:"title"`
+	ProposalType ProposalKind   `json:"proposal_type"`
+	Proposer     sdk.AccAddress `json:"proposer"`
+	Status       ProposalStatus `json:"proposal_status"`
+
+	SubmitTime      time.Time `json:"submit_time"`
+	DepositEndTime  time.Time `json:"deposit_end_time"`
+	TotalDeposit    sdk.Coins `json:"total_deposit"`
+	VotingStartTime time.Time `json:"voting_start_time"`
+	VotingEndTime   time.Time `json:"voting_end_time"`
+
+	TallyResult     TallyResult `json:"tally_result"`
+	ValidatorVotes  []Vote      `json:"validator_votes"`  // votes of the validators used in the final tally
+	ExecutionResult string      `json:"execution_result"` // empty if not executed, "success" or the abci log of the execution error
+}
+
+func NewProposalRecord(proposal Proposal, validatorVotes []Vote, executionResult string) ProposalRecord {
+	return ProposalRecord{
+		ProposalID:      proposal.GetProposalID(),
+		Title:           proposal.GetTitle(),
+		ProposalType:    proposal.GetProposalType(),
+		Proposer:        proposal.GetProposer(),
+		Status:          proposal.GetStatus(),
+		SubmitTime:      proposal.GetSubmitTime(),
+		DepositEndTime:  proposal.GetDepositEndTime(),
+		TotalDeposit:    proposal.GetTotalDeposit(),
+		VotingStartTime: proposal.GetVotingStartTime(),
+		VotingEndTime:   proposal.GetVotingEndTime(),
+		TallyResult:     proposal.GetTallyResult(),
+		ValidatorVotes:  validatorVotes,
+		ExecutionResult: executionResult,
+	}
+}
+
+func (pr ProposalRecord) String() string {
+	validatorVotes := ""
+	for _, vote := range pr.ValidatorVotes {
+		validatorVotes += fmt.Sprintf("\n    %s: %s", vote.Voter, vote.WeightedOptions())
+	}
+	return fmt.Sprintf(`Proposal Record %d:
+  Title:              %s
+  Type:               %s
+  Proposer:           %s
+  Status:             %s
+  Submit Time:        %s
+  Deposit End Time:   %s
+  Total Deposit:      %s
+  Voting Start Time:  %s
+  Voting End Time:    %s
+  Execution Result:   %s
+  Validator Votes:    %s
+%s`, pr.ProposalID, pr.Title, pr.ProposalType, pr.Proposer, pr.Status,
+		pr.SubmitTime, pr.DepositEndTime, pr.TotalDeposit.String(),
+		pr.VotingStartTime, pr.VotingEndTime, pr.ExecutionResult, validatorVotes, pr.TallyResult)
+}
+
+type ProposalRecords []ProposalRecord
+
+func (prs ProposalRecords) String() string {
+	if len(prs) == 0 {
+		return "[]"
+	}
+	out := "ID - (Status) [Type] Title - Execution Result\n"
+	for _, pr := range prs {
+		out += fmt.Sprintf("%d - (%s) [%s] %s - %s\n",
+			pr.ProposalID, pr.Status, pr.ProposalType, pr.Title, pr.ExecutionResult)
+	}
+	return strings.TrimSpace(out)
+}
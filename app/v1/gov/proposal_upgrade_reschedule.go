@@ -0,0 +1,80 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var _ Proposal = (*UpgradeRescheduleProposal)(nil)
+
+// UpgradeRescheduleProposal cancels or reschedules the software upgrade in progress
+type UpgradeRescheduleProposal struct {
+	BasicProposal
+	Version      uint64  `json:"version"`       // version of the upgrade in progress
+	Cancel       bool    `json:"cancel"`        // clear the upgrade in progress
+	SwitchHeight uint64  `json:"switch_height"` // new switch height, 0 to keep the current one
+	Threshold    sdk.Dec `json:"threshold"`     // new signal threshold, nil or 0 to keep the current one
+}
+
+func (rp UpgradeRescheduleProposal) HumanString(converter sdk.CoinsConverter) string {
+	bps := rp.BasicProposal.HumanString(converter)
+	return fmt.Sprintf(`%s
+  Version:            %d
+  Cancel:             %v
+  Switch Height:      %d
+  Threshold:          %s`,
+		bps, rp.Version, rp.Cancel, rp.SwitchHeight, rp.Threshold)
+}
+
+func (rp *UpgradeRescheduleProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Error {
+	if err := rp.BasicProposal.Validate(ctx, k, verify); err != nil {
+		return err
+	}
+
+	_, found := k.guardianKeeper.GetProfiler(ctx, rp.GetProposer())
+	if !found {
+		return ErrNotProfiler(k.codespace, rp.GetProposer())
+	}
+
+	return rp.validateUpgradeInProgress(ctx, k)
+}
+
+func (rp *UpgradeRescheduleProposal) validateUpgradeInProgress(ctx sdk.Context, k Keeper) sdk.Error {
+	upgradeConfig, ok := k.protocolKeeper.GetUpgradeConfig(ctx)
+	if !ok || upgradeConfig.Protocol.Version != rp.Version {
+		return ErrNoSwitchPeriodInProcess(k.codespace, rp.Version)
+	}
+
+	if !rp.Cancel && rp.SwitchHeight != 0 && uint64(ctx.BlockHeight())+1 >= rp.SwitchHeight {
+		return ErrCodeInvalidSwitchHeight(k.codespace, uint64(ctx.BlockHeight()), rp.SwitchHeight)
+	}
+	return nil
+}
+
+func (rp *UpgradeRescheduleProposal) Execute(ctx sdk.Context, gk Keeper) sdk.Error {
+	logger := ctx.Logger()
+
+	if err := rp.validateUpgradeInProgress(ctx, gk); err != nil {
+		logger.Info("Execute UpgradeRescheduleProposal Failure", "info", err.Error())
+		return err
+	}
+
+	upgradeConfig, _ := gk.protocolKeeper.GetUpgradeConfig(ctx)
+	if rp.Cancel {
+		gk.uk.CancelUpgrade(ctx, upgradeConfig)
+		logger.Info("Execute UpgradeRescheduleProposal Success", "cancelled", upgradeConfig.String())
+		return nil
+	}
+
+	if rp.SwitchHeight != 0 {
+		upgradeConfig.Protocol.Height = rp.SwitchHeight
+	}
+	if !rp.Threshold.IsNil() && !rp.Threshold.IsZero() {
+		upgradeConfig.Protocol.Threshold = rp.Threshold
+	}
+	gk.protocolKeeper.SetUpgradeConfig(ctx, upgradeConfig)
+
+	logger.Info("Execute UpgradeRescheduleProposal Success", "rescheduled", upgradeConfig.String())
+	return nil
+}
@@ -114,6 +114,41 @@ func createTokenAdditionInfo() pTypeInfo {
 	}
 }
 
+func createMsgsInfo() pTypeInfo {
+	return pTypeInfo{
+		ProposalTypeMsgs,
+		ProposalLevelImportant,
+		func(content Content) Proposal {
+			return buildProposal(content, func(p BasicProposal, content Content) Proposal {
+				msgsMsg, _ := content.(MsgSubmitMsgsProposal)
+				return &MsgsProposal{
+					p,
+					msgsMsg.Msgs,
+				}
+			})
+		},
+	}
+}
+
+func createUpgradeRescheduleInfo() pTypeInfo {
+	return pTypeInfo{
+		ProposalTypeUpgradeReschedule,
+		ProposalLevelCritical,
+		func(content Content) Proposal {
+			return buildProposal(content, func(p BasicProposal, content Content) Proposal {
+				rescheduleMsg := content.(MsgSubmitUpgradeRescheduleProposal)
+				return &UpgradeRescheduleProposal{
+					p,
+					rescheduleMsg.Version,
+					rescheduleMsg.Cancel,
+					rescheduleMsg.SwitchHeight,
+					rescheduleMsg.Threshold,
+				}
+			})
+		},
+	}
+}
+
 func buildProposal(content Content, callback func(p BasicProposal, content Content) Proposal) Proposal {
 	var p = BasicProposal{
 		Title:        content.GetTitle(),
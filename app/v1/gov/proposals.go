@@ -217,6 +217,8 @@ const (
 	ProposalTypeCommunityTaxUsage ProposalKind = 0x04
 	ProposalTypePlainText         ProposalKind = 0x05
 	ProposalTypeTokenAddition     ProposalKind = 0x06
+	ProposalTypeMsgs              ProposalKind = 0x07
+	ProposalTypeUpgradeReschedule ProposalKind = 0x08
 )
 
 var pTypeMap = map[string]pTypeInfo{
@@ -226,6 +228,8 @@ var pTypeMap = map[string]pTypeInfo{
 	"SystemHalt":        createSystemHaltInfo(),
 	"CommunityTaxUsage": createCommunityTaxUsageInfo(),
 	"TokenAddition":     createTokenAdditionInfo(),
+	"Msgs":              createMsgsInfo(),
+	"UpgradeReschedule": createUpgradeRescheduleInfo(),
 }
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -15,6 +15,12 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+
+	QueryTallySimulation       = "tally_simulation"
+	QueryProposalRecord        = "proposal_record"
+	QueryProposalRecords       = "proposal_records"
+	QueryRepresentative        = "representative"
+	QueryRepresentedDelegators = "represented_delegators"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -34,6 +40,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryTallySimulation:
+			return queryTallySimulation(ctx, path[1:], req, keeper)
+		case QueryProposalRecord:
+			return queryProposalRecord(ctx, path[1:], req, keeper)
+		case QueryProposalRecords:
+			return queryProposalRecords(ctx, path[1:], req, keeper)
+		case QueryRepresentative:
+			return queryRepresentative(ctx, path[1:], req, keeper)
+		case QueryRepresentedDelegators:
+			return queryRepresentedDelegators(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -272,3 +288,145 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+// Params for query 'custom/gov/tally_simulation'
+type QueryTallySimulationParams struct {
+	ProposalID uint64
+}
+
+// nolint: unparam
+func queryTallySimulation(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryTallySimulationParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	proposal := keeper.GetProposal(ctx, params.ProposalID)
+	if proposal == nil {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+	if proposal.GetStatus() != StatusVotingPeriod {
+		return nil, ErrInactiveProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, simulateTally(ctx, keeper, proposal))
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/proposal_record'
+type QueryProposalRecordParams struct {
+	ProposalID uint64
+}
+
+// nolint: unparam
+func queryProposalRecord(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryProposalRecordParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	record, found := keeper.GetProposalRecord(ctx, params.ProposalID)
+	if !found {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, record)
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/proposal_records'
+type QueryProposalRecordsParams struct {
+	ProposalStatus string
+	ProposalType   string
+	Page           uint64
+	Size           uint16
+}
+
+// nolint: unparam
+func queryProposalRecords(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryProposalRecordsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	var status = StatusNil
+	if len(params.ProposalStatus) > 0 {
+		status, err2 = ProposalStatusFromString(params.ProposalStatus)
+		if err2 != nil {
+			return nil, sdk.ParseParamsErr(err2)
+		}
+	}
+	var proposalType = ProposalTypeNil
+	if len(params.ProposalType) > 0 {
+		proposalType, err2 = ProposalTypeFromString(params.ProposalType)
+		if err2 != nil {
+			return nil, sdk.ParseParamsErr(err2)
+		}
+	}
+
+	records := keeper.GetProposalRecordsFiltered(ctx, status, proposalType, params.Page, params.Size)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/representative'
+type QueryRepresentativeParams struct {
+	Delegator sdk.AccAddress
+}
+
+// nolint: unparam
+func queryRepresentative(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRepresentativeParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	representative, found := keeper.GetRepresentative(ctx, params.Delegator)
+	if !found {
+		return nil, ErrRepresentativeNotExisted(DefaultCodespace, params.Delegator)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, representative)
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/represented_delegators'
+type QueryRepresentedDelegatorsParams struct {
+	Representative sdk.AccAddress
+}
+
+// nolint: unparam
+func queryRepresentedDelegators(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRepresentedDelegatorsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	delegators := keeper.GetRepresentedDelegators(ctx, params.Representative)
+	if delegators == nil {
+		delegators = RepresentedDelegators{}
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, delegators)
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
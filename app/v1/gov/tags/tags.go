@@ -24,4 +24,6 @@ var (
 	Percent           = "percent"
 	DestAddress       = "dest-address"
 	TokenId           = "token-id"
+	Delegator         = "delegator"
+	Representative    = "representative"
 )
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -14,24 +16,31 @@ const (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	Vote                WeightedVoteOptions // Vote of the validator, empty if not voted
 	TokenPerShare       sdk.Dec
 	DelegatorShares     sdk.Dec // Total outstanding delegator shares
 	DelegatorDeductions sdk.Dec // Delegator deductions from validator's delegators voting independently
 }
 
 func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalResult, tallyResults TallyResult, votingVals map[string]bool) {
-	results := make(map[VoteOption]sdk.Dec)
+	results, totalVotingPower, systemVotingPower, votingVals := sumVotes(ctx, keeper, proposal)
+	tallyingProcedure := keeper.GetTallyingProcedure(ctx, proposal.GetProposalLevel())
+	return decideResult(results, totalVotingPower, systemVotingPower, tallyingProcedure), newTallyResult(results, systemVotingPower), votingVals
+}
+
+// sumVotes sums the voting power of each option, the voted voting power and the voting power of all bonded validators
+func sumVotes(ctx sdk.Context, keeper Keeper, proposal Proposal) (results map[VoteOption]sdk.Dec, totalVotingPower, systemVotingPower sdk.Dec, votingVals map[string]bool) {
+	results = make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
 	results[OptionNo] = sdk.ZeroDec()
 	results[OptionNoWithVeto] = sdk.ZeroDec()
 
 	//voted votingPower
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
 	//all votingPower
-	systemVotingPower := sdk.ZeroDec()
+	systemVotingPower = sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
 	votingVals = make(map[string]bool)
 
@@ -39,41 +48,62 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalRe
 		currValidators[validator.GetOperator().String()] = validatorGovInfo{
 			Address:             validator.GetOperator(),
 			TokenPerShare:       validator.GetTokens().Quo(validator.GetDelegatorShares()),
-			Vote:                OptionEmpty,
 			DelegatorShares:     validator.GetDelegatorShares(),
 			DelegatorDeductions: sdk.ZeroDec(),
 		}
 		systemVotingPower = systemVotingPower.Add(validator.GetTokens())
 		return false
 	})
+	// tally the voting power of the delegations of a voter, deducted from the validators
+	tallyDelegations := func(voter sdk.AccAddress, options WeightedVoteOptions) {
+		valAddrStr := sdk.ValAddress(voter).String()
+		keeper.ds.IterateDelegations(ctx, voter, func(index int64, delegation sdk.Delegation) (stop bool) {
+			valAddr := delegation.GetValidatorAddr().String()
+			if valAddr == valAddrStr {
+				return false
+			}
+			//only tally the delegator voting power under the validator
+			if val, ok := currValidators[valAddr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddr] = val
+
+				votingPower := delegation.GetShares().Mul(val.TokenPerShare)
+				for _, option := range options {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+			return false
+		})
+	}
+
 	// iterate over all the votes
+	var votes []Vote
+	voted := make(map[string]bool)
 	votesIterator := keeper.GetVotes(ctx, proposal.GetProposalID())
 	defer votesIterator.Close()
 	for ; votesIterator.Valid(); votesIterator.Next() {
-		vote := &Vote{}
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), vote)
+		vote := Vote{}
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
+		votes = append(votes, vote)
+		voted[vote.Voter.String()] = true
+	}
 
+	for _, vote := range votes {
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			votingVals[valAddrStr] = true
 			currValidators[valAddrStr] = val
 		}
 		// if validator is also delegator
-		keeper.ds.IterateDelegations(ctx, vote.Voter, func(index int64, delegation sdk.Delegation) (stop bool) {
-			valAddr := delegation.GetValidatorAddr().String()
-			if valAddr == valAddrStr {
-				return false
-			}
-			//only tally the delegator voting power under the validator
-			if val, ok := currValidators[valAddr]; ok {
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddr] = val
+		tallyDelegations(vote.Voter, vote.WeightedOptions())
 
-				votingPower := delegation.GetShares().Mul(val.TokenPerShare)
-				results[vote.Option] = results[vote.Option].Add(votingPower)
-				totalVotingPower = totalVotingPower.Add(votingPower)
+		// the vote of a representative applies to the delegators who don't vote directly
+		keeper.IterateRepresentedDelegators(ctx, vote.Voter, func(delegator sdk.AccAddress) (stop bool) {
+			if !voted[delegator.String()] {
+				tallyDelegations(delegator, vote.WeightedOptions())
 			}
 			return false
 		})
@@ -81,47 +111,122 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalRe
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.Mul(val.TokenPerShare)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyingProcedure := keeper.GetTallyingProcedure(ctx, proposal.GetProposalLevel())
+	return results, totalVotingPower, systemVotingPower, votingVals
+}
 
-	tallyResults = TallyResult{
+func newTallyResult(results map[VoteOption]sdk.Dec, systemVotingPower sdk.Dec) TallyResult {
+	return TallyResult{
 		Yes:               results[OptionYes].QuoInt(sdk.AttoScaleFactor),
 		Abstain:           results[OptionAbstain].QuoInt(sdk.AttoScaleFactor),
 		No:                results[OptionNo].QuoInt(sdk.AttoScaleFactor),
 		NoWithVeto:        results[OptionNoWithVeto].QuoInt(sdk.AttoScaleFactor),
 		SystemVotingPower: systemVotingPower.QuoInt(sdk.AttoScaleFactor),
 	}
+}
 
+// decideResult applies the tallying procedure to the sums of the voting power
+func decideResult(results map[VoteOption]sdk.Dec, totalVotingPower, systemVotingPower sdk.Dec, tallyingProcedure TallyingProcedure) ProposalResult {
 	// If no one votes, proposal fails
 	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return REJECT, tallyResults, votingVals
+		return REJECT
 	}
 
 	//if more than 1/3 of voters abstain, proposal fails
 	if tallyingProcedure.Participation.GT(totalVotingPower.Quo(systemVotingPower)) {
-		return REJECT, tallyResults, votingVals
+		return REJECT
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyingProcedure.Veto) {
-		return REJECTVETO, tallyResults, votingVals
+		return REJECTVETO
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	if results[OptionYes].Quo(totalVotingPower).GT(tallyingProcedure.Threshold) {
-		return PASS, tallyResults, votingVals
+		return PASS
 	}
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 
-	return REJECT, tallyResults, votingVals
+	return REJECT
+}
+
+// TallySimulation is the projected outcome of a proposal in the voting period if it was tallied at the current state
+type TallySimulation struct {
+	ProposalID          uint64           `json:"proposal_id"`
+	Result              ProposalResult   `json:"result"`               // projected result with the tallying procedure of the proposal level
+	TallyResult         TallyResult      `json:"tally_result"`         // current sums of the voting power
+	Participation       sdk.Dec          `json:"participation"`        // voted voting power / system voting power
+	ParticipationMargin sdk.Dec          `json:"participation_margin"` // participation - required participation, negative if not reached
+	ThresholdMargin     sdk.Dec          `json:"threshold_margin"`     // yes ratio - threshold, must be positive to pass
+	VetoMargin          sdk.Dec          `json:"veto_margin"`          // veto - no with veto ratio, negative if vetoed
+	NonVotingValidators []sdk.ValAddress `json:"non_voting_validators"`
+}
+
+func (ts TallySimulation) String() string {
+	nonVotingVals := ""
+	for _, val := range ts.NonVotingValidators {
+		nonVotingVals += fmt.Sprintf("\n    %s", val)
+	}
+	return fmt.Sprintf(`Tally Simulation for Proposal %d:
+  Result:               %s
+  Participation:        %s
+  ParticipationMargin:  %s
+  ThresholdMargin:      %s
+  VetoMargin:           %s
+  NonVotingValidators:  %s
+%s`, ts.ProposalID, ts.Result, ts.Participation.String(), ts.ParticipationMargin.String(),
+		ts.ThresholdMargin.String(), ts.VetoMargin.String(), nonVotingVals, ts.TallyResult)
+}
+
+// simulateTally runs the tally of a proposal against the current state without changing it
+func simulateTally(ctx sdk.Context, keeper Keeper, proposal Proposal) TallySimulation {
+	results, totalVotingPower, systemVotingPower, votingVals := sumVotes(ctx, keeper, proposal)
+	tallyingProcedure := keeper.GetTallyingProcedure(ctx, proposal.GetProposalLevel())
+
+	// the margins are computed from the same sums as the result, not from the truncated tally result
+	participation, yesRatio, vetoRatio := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	if systemVotingPower.IsPositive() {
+		participation = totalVotingPower.Quo(systemVotingPower)
+	}
+	if totalVotingPower.IsPositive() {
+		yesRatio = results[OptionYes].Quo(totalVotingPower)
+		vetoRatio = results[OptionNoWithVeto].Quo(totalVotingPower)
+	}
+
+	// the validators in the validator set snapshot of the proposal who haven't voted,
+	// only the bonded ones are listed as they are penalized at the end of the voting period
+	nonVotingVals := []sdk.ValAddress{}
+	for _, valAddr := range keeper.GetValidatorSet(ctx, proposal.GetProposalID()) {
+		if votingVals[valAddr.String()] {
+			continue
+		}
+		val := keeper.vs.Validator(ctx, valAddr)
+		if val != nil && val.GetStatus() == sdk.Bonded {
+			nonVotingVals = append(nonVotingVals, valAddr)
+		}
+	}
+
+	return TallySimulation{
+		ProposalID:          proposal.GetProposalID(),
+		Result:              decideResult(results, totalVotingPower, systemVotingPower, tallyingProcedure),
+		TallyResult:         newTallyResult(results, systemVotingPower),
+		Participation:       participation,
+		ParticipationMargin: participation.Sub(tallyingProcedure.Participation),
+		ThresholdMargin:     yesRatio.Sub(tallyingProcedure.Threshold),
+		VetoMargin:          tallyingProcedure.Veto.Sub(vetoRatio),
+		NonVotingValidators: nonVotingVals,
+	}
 }
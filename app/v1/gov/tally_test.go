@@ -0,0 +1,228 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/stake"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// the stake of the validators and delegators in the tests, in iris
+const (
+	testSelfDelegation = 100
+	testDelegation     = 50
+)
+
+// setupTally creates a bonded validator for each of the first numVals accounts,
+// and a proposal in the voting period with the validators as its validator set
+func setupTally(t *testing.T, numGenAccs int, numVals int) (sdk.Context, Keeper, stake.Keeper, []sdk.AccAddress, uint64) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, numGenAccs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	for i := 0; i < numVals; i++ {
+		createTestValidator(t, ctx, sk, addrs[i], pubKeys[i])
+	}
+	stake.EndBlocker(ctx, sk)
+
+	proposal := &PlainTextProposal{BasicProposal{
+		ProposalID:   1,
+		Title:        "Test",
+		Description:  "Test",
+		ProposalType: ProposalTypePlainText,
+		Status:       StatusVotingPeriod,
+	}}
+	keeper.SetProposal(ctx, proposal)
+	keeper.SetValidatorSet(ctx, proposal.GetProposalID())
+	return ctx, keeper, sk, addrs, proposal.GetProposalID()
+}
+
+func createTestValidator(t *testing.T, ctx sdk.Context, sk stake.Keeper, addr sdk.AccAddress, pubKey crypto.PubKey) {
+	msg := stake.NewMsgCreateValidator(sdk.ValAddress(addr), pubKey, testStakeCoin(testSelfDelegation),
+		stake.Description{Moniker: addr.String()}, stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()))
+	res := stake.NewHandler(sk)(ctx, msg)
+	require.True(t, res.IsOK(), "%v", res)
+}
+
+func delegate(t *testing.T, ctx sdk.Context, sk stake.Keeper, delegator sdk.AccAddress, validator sdk.AccAddress) {
+	res := stake.NewHandler(sk)(ctx, stake.NewMsgDelegate(delegator, sdk.ValAddress(validator), testStakeCoin(testDelegation)))
+	require.True(t, res.IsOK(), "%v", res)
+}
+
+func testStakeCoin(amount int64) sdk.Coin {
+	return sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(amount, 18))
+}
+
+// testVotingPower returns the voting power of the given iris
+func testVotingPower(amount sdk.Dec) sdk.Dec {
+	return amount.MulInt(sdk.NewIntWithDecimal(1, 18))
+}
+
+func newTestWeightedVoteOptions(t *testing.T, str string) WeightedVoteOptions {
+	options, err := WeightedVoteOptionsFromString(str)
+	require.NoError(t, err)
+	return options
+}
+
+func TestValidWeightedVoteOptions(t *testing.T) {
+	tests := []struct {
+		options string
+		valid   bool
+	}{
+		{"Yes=1", true},
+		{"Yes=0.6,No=0.4", true},
+		{"Yes=0.1,Abstain=0.2,No=0.3,NoWithVeto=0.4", true},
+		{"Yes=0.5,No=0.4", false},         // sum below 1
+		{"Yes=0.6,No=0.5", false},         // sum above 1
+		{"Yes=0.5,Yes=0.5", false},        // duplicate options
+		{"Yes=0.5,No=0.3,Yes=0.2", false}, // duplicate options not adjacent
+		{"Yes=1,No=0", false},             // zero weight
+		{"Yes=1.5,No=-0.5", false},        // negative weight
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.valid, ValidWeightedVoteOptions(newTestWeightedVoteOptions(t, tc.options)), tc.options)
+	}
+
+	require.False(t, ValidWeightedVoteOptions(nil))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionEmpty, sdk.OneDec()}}))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionYes, sdk.Dec{}}}))
+}
+
+func TestAddWeightedVote(t *testing.T) {
+	ctx, keeper, _, addrs, proposalID := setupTally(t, 2, 1)
+
+	err := keeper.AddWeightedVote(ctx, proposalID, addrs[0], newTestWeightedVoteOptions(t, "Yes=0.5,No=0.4"))
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidVote, err.Code())
+
+	options := newTestWeightedVoteOptions(t, "Yes=0.6,No=0.4")
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[0], options))
+	vote, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.True(t, options.Equals(vote.WeightedOptions()))
+
+	// neither a validator nor a delegator
+	require.NotNil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[1], options))
+}
+
+func TestTallyWeightedVoteValidators(t *testing.T) {
+	ctx, keeper, _, addrs, proposalID := setupTally(t, 2, 2)
+
+	// the voting power of each validator is split into every bucket by the weights
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[0], newTestWeightedVoteOptions(t, "Yes=0.1,Abstain=0.2,No=0.3,NoWithVeto=0.4")))
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[1], newTestWeightedVoteOptions(t, "Yes=0.5,Abstain=0.5")))
+
+	results, totalVotingPower, systemVotingPower, votingVals := sumVotes(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, testVotingPower(sdk.NewDec(60)), results[OptionYes])
+	require.Equal(t, testVotingPower(sdk.NewDec(70)), results[OptionAbstain])
+	require.Equal(t, testVotingPower(sdk.NewDec(30)), results[OptionNo])
+	require.Equal(t, testVotingPower(sdk.NewDec(40)), results[OptionNoWithVeto])
+	require.Equal(t, testVotingPower(sdk.NewDec(200)), totalVotingPower)
+	require.Equal(t, testVotingPower(sdk.NewDec(200)), systemVotingPower)
+	require.Len(t, votingVals, 2)
+
+	// yes 60 of 200 doesn't reach the threshold
+	result, tallyResult, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, REJECT, result)
+	require.Equal(t, sdk.NewDec(60), tallyResult.Yes)
+	require.Equal(t, sdk.NewDec(70), tallyResult.Abstain)
+	require.Equal(t, sdk.NewDec(30), tallyResult.No)
+	require.Equal(t, sdk.NewDec(40), tallyResult.NoWithVeto)
+}
+
+func TestTallyWeightedVoteDelegator(t *testing.T) {
+	ctx, keeper, sk, addrs, proposalID := setupTally(t, 2, 1)
+	delegate(t, ctx, sk, addrs[1], addrs[0])
+
+	// the delegator splits its voting power, which is deducted from the validator
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[1], newTestWeightedVoteOptions(t, "No=0.6,NoWithVeto=0.4")))
+
+	results, totalVotingPower, systemVotingPower, _ := sumVotes(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, testVotingPower(sdk.NewDec(testSelfDelegation)), results[OptionYes])
+	require.Equal(t, sdk.ZeroDec(), results[OptionAbstain])
+	require.Equal(t, testVotingPower(sdk.NewDec(30)), results[OptionNo])
+	require.Equal(t, testVotingPower(sdk.NewDec(20)), results[OptionNoWithVeto])
+	require.Equal(t, testVotingPower(sdk.NewDec(150)), totalVotingPower)
+	require.Equal(t, testVotingPower(sdk.NewDec(150)), systemVotingPower)
+
+	// the veto of 20 of 150 doesn't reach the veto threshold, and yes 100 of 150 passes
+	result, _, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, PASS, result)
+}
+
+func TestSetRepresentative(t *testing.T) {
+	ctx, keeper, _, addrs, _ := setupTally(t, 4, 1)
+
+	require.NotNil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[1]))
+	require.False(t, keeper.isRepresentative(ctx, addrs[1]))
+
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[2]))
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[3], addrs[2]))
+	representative, found := keeper.GetRepresentative(ctx, addrs[1])
+	require.True(t, found)
+	require.Equal(t, addrs[2], representative)
+	require.True(t, keeper.isRepresentative(ctx, addrs[2]))
+	require.Len(t, keeper.GetRepresentedDelegators(ctx, addrs[2]), 2)
+
+	// changing the representative moves the delegator to the new one
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[0]))
+	require.Equal(t, RepresentedDelegators{addrs[3]}, keeper.GetRepresentedDelegators(ctx, addrs[2]))
+	require.Equal(t, RepresentedDelegators{addrs[1]}, keeper.GetRepresentedDelegators(ctx, addrs[0]))
+
+	// removing the representatives
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], nil))
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[3], nil))
+	_, found = keeper.GetRepresentative(ctx, addrs[1])
+	require.False(t, found)
+	require.False(t, keeper.isRepresentative(ctx, addrs[0]))
+	require.False(t, keeper.isRepresentative(ctx, addrs[2]))
+}
+
+func TestTallyRepresentative(t *testing.T) {
+	ctx, keeper, sk, addrs, proposalID := setupTally(t, 4, 1)
+	delegate(t, ctx, sk, addrs[1], addrs[0])
+	delegate(t, ctx, sk, addrs[2], addrs[0])
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[3]))
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[2], addrs[3]))
+
+	// the representative without any delegation can vote on behalf of the delegators
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[3], newTestWeightedVoteOptions(t, "No=0.6,NoWithVeto=0.4")))
+	// the delegator who votes overrides the vote of the representative
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionAbstain))
+
+	// the delegator who doesn't vote inherits the weighted options of the representative
+	results, totalVotingPower, systemVotingPower, _ := sumVotes(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, testVotingPower(sdk.NewDec(testSelfDelegation)), results[OptionYes])
+	require.Equal(t, testVotingPower(sdk.NewDec(testDelegation)), results[OptionAbstain])
+	require.Equal(t, testVotingPower(sdk.NewDec(30)), results[OptionNo])
+	require.Equal(t, testVotingPower(sdk.NewDec(20)), results[OptionNoWithVeto])
+	require.Equal(t, testVotingPower(sdk.NewDec(200)), totalVotingPower)
+	require.Equal(t, testVotingPower(sdk.NewDec(200)), systemVotingPower)
+}
+
+func TestTallyRemovedRepresentative(t *testing.T) {
+	ctx, keeper, sk, addrs, proposalID := setupTally(t, 3, 1)
+	delegate(t, ctx, sk, addrs[1], addrs[0])
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[2]))
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[2], OptionNo))
+
+	results, _, _, _ := sumVotes(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, testVotingPower(sdk.NewDec(testSelfDelegation)), results[OptionYes])
+	require.Equal(t, testVotingPower(sdk.NewDec(testDelegation)), results[OptionNo])
+
+	// once removed, the vote of the representative no longer applies to the delegator,
+	// whose voting power follows the validator again
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], nil))
+	require.False(t, keeper.isRepresentative(ctx, addrs[2]))
+	results, totalVotingPower, _, _ := sumVotes(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, testVotingPower(sdk.NewDec(testSelfDelegation+testDelegation)), results[OptionYes])
+	require.Equal(t, sdk.ZeroDec(), results[OptionNo])
+	require.Equal(t, testVotingPower(sdk.NewDec(testSelfDelegation+testDelegation)), totalVotingPower)
+}
@@ -15,11 +15,10 @@ import (
 
 	"fmt"
 
-	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/bank"
 	"github.com/irisnet/irishub/app/v1/distribution"
-	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/stake"
+	"github.com/irisnet/irishub/app/v1/upgrade"
 	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/server/mock"
 	sdk "github.com/irisnet/irishub/types"
@@ -35,16 +34,8 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	keyGov := sdk.NewKVStoreKey("gov")
 	keyDistr := sdk.NewKVStoreKey("distr")
 
-	paramsKeeper := params.NewKeeper(
-		mapp.Cdc,
-		sdk.NewKVStoreKey("params"),
-		sdk.NewTransientStoreKey("transient_params"),
-	)
-	feeKeeper := auth.NewFeeKeeper(
-		mapp.Cdc,
-		sdk.NewKVStoreKey("fee"),
-		paramsKeeper.Subspace(auth.DefaultParamSpace),
-	)
+	paramsKeeper := mapp.ParamsKeeper
+	feeKeeper := mapp.FeeKeeper
 
 	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.AccountKeeper)
 	sk := stake.NewKeeper(
@@ -54,17 +45,20 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 		stake.DefaultCodespace,
 		stake.NopMetrics())
 	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, paramsKeeper.Subspace(distribution.DefaultParamspace), ck, sk, feeKeeper, DefaultCodespace, distribution.NopMetrics())
-	guardianKeeper := guardian.NewKeeper(mapp.Cdc, sdk.NewKVStoreKey("guardian"), guardian.DefaultCodespace)
+	guardianKeeper := guardian.NewKeeper(mapp.Cdc, mapp.KeyGuardian, guardian.DefaultCodespace)
 	ak := asset.NewKeeper(mapp.Cdc, protocol.KeyAsset, ck, asset.DefaultCodespace, paramsKeeper.Subspace(asset.DefaultParamSpace))
 
-	gk := NewKeeper(keyGov, mapp.Cdc, paramsKeeper.Subspace(DefaultParamSpace), paramsKeeper, sdk.NewProtocolKeeper(sdk.NewKVStoreKey("main")), ck, dk, guardianKeeper, sk, DefaultCodespace, NopMetrics(), ak)
+	protocolKeeper := sdk.NewProtocolKeeper(mapp.KeyMain)
+	uk := upgrade.NewKeeper(mapp.Cdc, mapp.KeyUpgrade, protocolKeeper, sk, upgrade.NopMetrics())
+
+	gk := NewKeeper(keyGov, mapp.Cdc, paramsKeeper.Subspace(DefaultParamSpace), paramsKeeper, protocolKeeper, ck, dk, guardianKeeper, sk, DefaultCodespace, NopMetrics(), ak, protocol.NewRouter(), uk)
 
 	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{keyGov}, NewHandler(gk))
 
 	mapp.SetEndBlocker(getEndBlocker(gk))
 	mapp.SetInitChainer(getInitChainer(mapp, gk, sk))
 
-	require.NoError(t, mapp.CompleteSetup(keyGov))
+	require.NoError(t, mapp.CompleteSetup(keyGov, keyDistr, protocol.KeyAsset))
 
 	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", 1042, sdk.Iris))
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
@@ -27,6 +27,7 @@ const (
 	CodeInvalidQueryParams       sdk.CodeType = 113
 	CodeInvalidMaxProposalNum    sdk.CodeType = 114
 	CodeInvalidSystemHaltPeriod  sdk.CodeType = 115
+	CodeInvalidCancelBurnRate    sdk.CodeType = 116
 
 	//service
 	CodeInvalidMaxRequestTimeout    sdk.CodeType = 200
@@ -48,6 +49,8 @@ const (
 	CodeInvalidMaxValidators sdk.CodeType = 501
 	CodeInvalidBondDenom     sdk.CodeType = 502
 
+	CodeInvalidCommissionNoticePeriod sdk.CodeType = 503
+
 	//auth
 	CodeInvalidGasPriceThreshold sdk.CodeType = 600
 	CodeInvalidTxSizeLimit       sdk.CodeType = 601
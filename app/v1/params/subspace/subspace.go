@@ -149,6 +149,13 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// Get to ParamSet, do not modify the fields whose stored parameters are nil
+func (s Subspace) GetParamSetIfExists(ctx sdk.Context, ps ParamSet) {
+	for _, pair := range ps.KeyValuePairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
+
 // Set from ParamSet
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.KeyValuePairs() {
@@ -239,7 +239,8 @@ func (p *ProtocolV1) configKeepers() {
 	p.slashingKeeper = slashing.NewKeeper(
 		p.cdc,
 		protocol.KeySlashing,
-		&stakeKeeper, p.paramsKeeper.Subspace(slashing.DefaultParamspace),
+		&stakeKeeper, p.bankKeeper,
+		p.paramsKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 		slashing.PrometheusMetrics(p.config),
 	)
@@ -277,6 +278,8 @@ func (p *ProtocolV1) configKeepers() {
 		gov.DefaultCodespace,
 		gov.PrometheusMetrics(p.config),
 		p.assetKeeper,
+		p.router,
+		p.upgradeKeeper,
 	)
 
 	p.randKeeper = rand.NewKeeper(p.cdc, protocol.KeyRand, rand.DefaultCodespace)
@@ -299,10 +302,12 @@ func (p *ProtocolV1) configRouters() {
 		AddRoute(protocol.AccountRoute, bank.NewQuerier(p.bankKeeper, p.cdc)).
 		AddRoute(protocol.GovRoute, gov.NewQuerier(p.govKeeper)).
 		AddRoute(protocol.StakeRoute, stake.NewQuerier(p.StakeKeeper, p.cdc)).
+		AddRoute(protocol.SlashingRoute, slashing.NewQuerier(p.slashingKeeper)).
 		AddRoute(protocol.DistrRoute, distr.NewQuerier(p.distrKeeper)).
 		AddRoute(protocol.GuardianRoute, guardian.NewQuerier(p.guardianKeeper)).
 		AddRoute(protocol.ServiceRoute, service.NewQuerier(p.serviceKeeper)).
 		AddRoute(protocol.ParamsRoute, params.NewQuerier(p.paramsKeeper)).
+		AddRoute(protocol.UpgradeRoute, upgrade.NewQuerier(p.upgradeKeeper)).
 		AddRoute(protocol.AssetRoute, asset.NewQuerier(p.assetKeeper)).
 		AddRoute(protocol.RandRoute, rand.NewQuerier(p.randKeeper))
 
@@ -370,6 +375,8 @@ func (p *ProtocolV1) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 	tags = tags.AppendTags(slashing.EndBlocker(ctx, req, p.slashingKeeper))
 	tags = tags.AppendTags(service.EndBlocker(ctx, p.serviceKeeper))
 	tags = tags.AppendTags(upgrade.EndBlocker(ctx, p.upgradeKeeper))
+	tags = tags.AppendTags(asset.EndBlocker(ctx, p.assetKeeper))
+	tags = tags.AppendTags(stake.ApplyMatureCommissions(ctx, p.StakeKeeper))
 	validatorUpdates := stake.EndBlocker(ctx, p.StakeKeeper)
 	if p.trackCoinFlow {
 		ctx.CoinFlowTags().TagWrite()
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"encoding/binary"
+	"strconv"
+
 	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/service/tags"
 	"github.com/irisnet/irishub/types"
@@ -9,10 +12,12 @@ import (
 func EndBlocker(ctx types.Context, keeper Keeper) (resTags types.Tags) {
 	ctx = ctx.WithLogger(ctx.Logger().With("handler", "endBlock").With("module", "iris/service"))
 	logger := ctx.Logger()
+
+	// the subscription requests follow the requests of the txs in the block
+	resTags = sendSubscriptionRequests(ctx, keeper)
+
 	// Reset the intra-transaction counter.
 	keeper.SetIntraTxCounter(ctx, 0)
-
-	resTags = types.NewTags()
 	params := keeper.GetParamSet(ctx)
 	slashFraction := params.SlashFraction
 
@@ -45,11 +50,11 @@ func EndBlocker(ctx types.Context, keeper Keeper) (resTags types.Tags) {
 			}
 		}
 
+		keeper.RecordTimeoutRequest(ctx, req, slashCoins)
 		keeper.AddReturnFee(ctx, req.Consumer, req.ServiceFee)
 
-		keeper.DeleteActiveRequest(ctx, req)
+		keeper.CloseRequest(ctx, req)
 		keeper.metrics.ActiveRequests.Add(-1)
-		keeper.DeleteRequestExpiration(ctx, req)
 
 		resTags = resTags.AppendTag(tags.Action, tags.ActionSvcCallTimeOut)
 		resTags = resTags.AppendTag(tags.RequestID, []byte(req.RequestID()))
@@ -60,3 +65,52 @@ func EndBlocker(ctx types.Context, keeper Keeper) (resTags types.Tags) {
 
 	return resTags
 }
+
+// send the requests of the subscriptions scheduled at the current block,
+// the subscriptions are closed when all requests are sent or the escrow runs out
+func sendSubscriptionRequests(ctx types.Context, keeper Keeper) (resTags types.Tags) {
+	logger := ctx.Logger()
+	resTags = types.NewTags()
+
+	var ids []uint64
+	iterator := keeper.SubscriptionQueueIterator(ctx, ctx.BlockHeight())
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		sub, found := keeper.GetSubscription(ctx, id)
+		if !found {
+			continue
+		}
+
+		sub, request, err := addSubscriptionRequest(ctx, keeper, sub)
+		switch {
+		case err == nil:
+			resTags = resTags.AppendTag(tags.Action, tags.ActionSvcSubscriptionRequest)
+			resTags = resTags.AppendTag(tags.SubscriptionID, []byte(strconv.FormatUint(sub.ID, 10)))
+			resTags = resTags.AppendTag(tags.RequestID, []byte(request.RequestID()))
+			resTags = resTags.AppendTag(tags.Provider, []byte(request.Provider.String()))
+		case err.Code() == CodeInvalidSubscription:
+			// the escrow runs out
+			sub.Repetitions = sub.MaxRepetitions
+		default:
+			// the binding is not available for now, try again in the next period
+			logger.Info("Skip subscription request", "subscription_id", sub.ID, "err", err.Error())
+		}
+
+		if !sub.Finished() {
+			keeper.RescheduleSubscription(ctx, sub)
+			continue
+		}
+		if err := keeper.CloseSubscription(ctx, sub); err != nil {
+			panic(err)
+		}
+		resTags = resTags.AppendTag(tags.Action, tags.ActionSvcSubscriptionClosed)
+		resTags = resTags.AppendTag(tags.SubscriptionID, []byte(strconv.FormatUint(sub.ID, 10)))
+		logger.Info("Close subscription", "subscription_id", sub.ID, "consumer", sub.Consumer.String(), "escrow", sub.Escrow.String())
+	}
+	return resTags
+}
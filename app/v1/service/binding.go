@@ -20,6 +20,7 @@ type SvcBinding struct {
 	Level       Level          `json:"level"`
 	Available   bool           `json:"available"`
 	DisableTime time.Time      `json:"disable_time"`
+	DefVersion  uint64         `json:"def_version"` // the version of the service definition, 0 for the bindings before versioning
 }
 
 type Level struct {
@@ -43,6 +44,31 @@ func NewSvcBinding(ctx sdk.Context, defChainID, defName, bindChainID string, pro
 	}
 }
 
+// BindingStats records the service history of a binding
+type BindingStats struct {
+	ServedRequests       uint64    `json:"served_requests"`        // number of requests responded in time
+	TimeoutRequests      uint64    `json:"timeout_requests"`       // number of requests timed out
+	SlashedCoins         sdk.Coins `json:"slashed_coins"`          // total deposit slashed for timeouts
+	TotalResponseHeights uint64    `json:"total_response_heights"` // sum of the block height deltas between requests and responses
+}
+
+// SuccessRate returns the ratio of responded requests to all finished requests
+func (stats BindingStats) SuccessRate() sdk.Dec {
+	total := stats.ServedRequests + stats.TimeoutRequests
+	if total == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(stats.ServedRequests)).Quo(sdk.NewDec(int64(total)))
+}
+
+// AvgResponseHeight returns the average block height delta between requests and responses
+func (stats BindingStats) AvgResponseHeight() sdk.Dec {
+	if stats.ServedRequests == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(stats.TotalResponseHeights)).Quo(sdk.NewDec(int64(stats.ServedRequests)))
+}
+
 func SvcBindingEqual(bindingA, bindingB SvcBinding) bool {
 	if bindingA.DefChainID == bindingB.DefChainID &&
 		bindingA.DefName == bindingB.DefName &&
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/irisnet/irishub/tools/protoidl"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/pkg/errors"
 )
@@ -24,6 +25,10 @@ type MethodProperty struct {
 	Description   string            `json:"description"`
 	OutputPrivacy OutputPrivacyEnum `json:"output_privacy"`
 	OutputCached  OutputCachedEnum  `json:"output_cached"`
+	// descriptors of the request and response messages in the idl, used to validate the payloads.
+	// The methods defined before the descriptors were added have no descriptors, so their payloads are not validated
+	RequestDescriptor  protoidl.MessageDescriptor `json:"request_descriptor"`
+	ResponseDescriptor protoidl.MessageDescriptor `json:"response_descriptor"`
 }
 
 func NewSvcDef(name, chainId, description string, tags []string, author sdk.AccAddress, authorDescription, idlContent string) SvcDef {
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// the scheme of the outputs encrypted to the consumer's public key,
+// compatible with the ECIES implementation of btcec
+const EncryptionSchemeECIES = "ecies-secp256k1-aes256cbc-hmacsha256"
+
+// length of the ephemeral public key in the encrypted output: curve(2) + len(2) + X(32) + len(2) + Y(32)
+const eciesPubKeyLength = 70
+
+// the curve id (714) and coordinate length (32) in the encrypted output
+var (
+	eciesCurveBytes  = []byte{0x02, 0xCA}
+	eciesCoordLength = []byte{0x00, 0x20}
+)
+
+// EncryptOutput encrypts the service output to the given secp256k1 public key
+func EncryptOutput(pubKey crypto.PubKey, output []byte) ([]byte, error) {
+	secpPubKey, ok := pubKey.(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return nil, errors.New("only secp256k1 public keys are supported for output encryption")
+	}
+
+	key, err := btcec.ParsePubKey(secpPubKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return btcec.Encrypt(key, output)
+}
+
+// DecryptOutput decrypts the service output with the given secp256k1 private key
+func DecryptOutput(privKey crypto.PrivKey, output []byte) ([]byte, error) {
+	secpPrivKey, ok := privKey.(secp256k1.PrivKeySecp256k1)
+	if !ok {
+		return nil, errors.New("only secp256k1 private keys are supported for output decryption")
+	}
+
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), secpPrivKey[:])
+	return btcec.Decrypt(key, output)
+}
+
+// validateEncryptedOutput checks that the output is of the ECIES format:
+// IV(16) || ephemeral public key(70) || cipher text(n*16) || HMAC(32).
+// The recipient can not be verified on chain, only the consumer is able to decrypt it.
+func validateEncryptedOutput(output []byte) error {
+	if len(output) < aes.BlockSize+eciesPubKeyLength+aes.BlockSize+sha256.Size {
+		return errors.New("the encrypted output is too short")
+	}
+	if (len(output)-aes.BlockSize-eciesPubKeyLength-sha256.Size)%aes.BlockSize != 0 {
+		return errors.New("the cipher text of the encrypted output is not padded")
+	}
+
+	pubKey := output[aes.BlockSize : aes.BlockSize+eciesPubKeyLength]
+	if !bytes.Equal(pubKey[0:2], eciesCurveBytes) || !bytes.Equal(pubKey[2:4], eciesCoordLength) || !bytes.Equal(pubKey[36:38], eciesCoordLength) {
+		return errors.New("the ephemeral public key of the encrypted output is not on secp256k1")
+	}
+
+	pb := make([]byte, 65)
+	pb[0] = 0x04 // uncompressed
+	copy(pb[1:33], pubKey[4:36])
+	copy(pb[33:], pubKey[38:70])
+	if _, err := btcec.ParsePubKey(pb, btcec.S256()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestEncryptOutput(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	output := []byte("personal data")
+
+	encrypted, err := EncryptOutput(privKey.PubKey(), output)
+	require.NoError(t, err)
+	require.NoError(t, validateEncryptedOutput(encrypted))
+	require.Error(t, validateEncryptedOutput(output))
+	require.Error(t, validateEncryptedOutput(encrypted[1:]))
+
+	decrypted, err := DecryptOutput(privKey, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, output, decrypted)
+
+	_, err = DecryptOutput(secp256k1.GenPrivKey(), encrypted)
+	require.Error(t, err)
+
+	_, err = EncryptOutput(ed25519.GenPrivKey().PubKey(), output)
+	require.Error(t, err)
+}
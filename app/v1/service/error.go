@@ -43,6 +43,15 @@ const (
 
 	CodeIntOverflow  sdk.CodeType = 130
 	CodeInvalidInput sdk.CodeType = 131
+
+	CodeNotMatchingConsumer sdk.CodeType = 132
+	CodeInvalidMulticast    sdk.CodeType = 133
+	CodeInvalidEncryption   sdk.CodeType = 134
+	CodeInvalidPayload      sdk.CodeType = 135
+	CodeSvcDefDeprecated    sdk.CodeType = 136
+	CodeNotMatchingAuthor   sdk.CodeType = 137
+	CodeInvalidPricing      sdk.CodeType = 138
+	CodeInvalidSubscription sdk.CodeType = 139
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -74,6 +83,22 @@ func ErrSvcDefNotExists(codespace sdk.CodespaceType, defChainId, svcDefName stri
 	return sdk.NewError(codespace, CodeSvcDefNotExists, fmt.Sprintf("service definition name %s is not existed in %s", svcDefName, defChainId))
 }
 
+func ErrSvcDefVersionNotExists(codespace sdk.CodespaceType, defChainId, svcDefName string, version uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeSvcDefNotExists, fmt.Sprintf("version %d of service definition %s is not existed in %s", version, svcDefName, defChainId))
+}
+
+func ErrInvalidDefVersion(codespace sdk.CodespaceType, version uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid version %d of service definition", version))
+}
+
+func ErrSvcDefDeprecated(codespace sdk.CodespaceType, defChainId, svcDefName string, version uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeSvcDefDeprecated, fmt.Sprintf("version %d of service definition %s in %s is deprecated", version, svcDefName, defChainId))
+}
+
+func ErrNotMatchingAuthor(codespace sdk.CodespaceType, author sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMatchingAuthor, fmt.Sprintf("[%s] is not the author of the service definition", author))
+}
+
 func ErrInvalidIDL(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidIDL, fmt.Sprintf("The IDL content cannot be parsed, %s", msg))
 }
@@ -174,6 +199,10 @@ func ErrNotMatchingProvider(codespace sdk.CodespaceType, provider sdk.AccAddress
 	return sdk.NewError(codespace, CodeNotMatchingProvider, fmt.Sprintf("[%s] is not a matching Provider", provider.String()))
 }
 
+func ErrNotMatchingConsumer(codespace sdk.CodespaceType, consumer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMatchingConsumer, fmt.Sprintf("[%s] is not the consumer of the request", consumer.String()))
+}
+
 func ErrInvalidReqChainId(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidReqChainId, fmt.Sprintf("request chain id is empty"))
 }
@@ -197,3 +226,31 @@ func ErrNotProfiler(codespace sdk.CodespaceType, profiler sdk.AccAddress) sdk.Er
 func ErrNoResponseFound(codespace sdk.CodespaceType, requestID string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("response is not existed for request %s", requestID))
 }
+
+func ErrInvalidMulticast(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMulticast, fmt.Sprintf("invalid multicast request, %s", msg))
+}
+
+func ErrNoMulticastRequestFound(codespace sdk.CodespaceType, multicastRequestID string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("multicast request %s is not existed", multicastRequestID))
+}
+
+func ErrInvalidEncryptedOutput(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEncryption, fmt.Sprintf("the output must be encrypted to the public key of the consumer, %s", msg))
+}
+
+func ErrInvalidPayload(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPayload, fmt.Sprintf("the payload does not match the idl, %s", msg))
+}
+
+func ErrInvalidPricing(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPricing, fmt.Sprintf("invalid pricing rules, %s", msg))
+}
+
+func ErrInvalidSubscription(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSubscription, fmt.Sprintf("invalid subscription, %s", msg))
+}
+
+func ErrSubscriptionNotExists(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSubscription, fmt.Sprintf("subscription %d is not existed", id))
+}
@@ -45,6 +45,7 @@ func DefaultGenesisStateForTest() GenesisState {
 
 // refund deposit from all bindings
 // refund service fee from all request
+// refund escrow from all subscriptions
 // refund all incoming/return fee
 // no process for service fee tax account
 func PrepForZeroHeightGenesis(ctx sdk.Context, k Keeper) {
@@ -68,6 +69,15 @@ func PrepForZeroHeightGenesis(ctx sdk.Context, k Keeper) {
 		k.ck.SendCoins(ctx, auth.ServiceRequestCoinsAccAddr, request.Consumer, request.ServiceFee)
 	}
 
+	// refund escrow from all subscriptions
+	subscriptionIterator := sdk.KVStorePrefixIterator(store, subscriptionKey)
+	defer subscriptionIterator.Close()
+	for ; subscriptionIterator.Valid(); subscriptionIterator.Next() {
+		var sub Subscription
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(subscriptionIterator.Value(), &sub)
+		k.ck.SendCoins(ctx, auth.ServiceRequestCoinsAccAddr, sub.Consumer, sub.Escrow)
+	}
+
 	// refund all incoming fee
 	incomingFeeIterator := sdk.KVStorePrefixIterator(store, incomingFeeKey)
 	defer incomingFeeIterator.Close()
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/service/tags"
 	sdk "github.com/irisnet/irishub/types"
@@ -12,10 +15,16 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSvcDef:
 			return handleMsgSvcDef(ctx, k, msg)
+		case MsgSvcDefVersion:
+			return handleMsgSvcDefVersion(ctx, k, msg)
+		case MsgSvcDefDeprecate:
+			return handleMsgSvcDefDeprecate(ctx, k, msg)
 		case MsgSvcBind:
 			return handleMsgSvcBind(ctx, k, msg)
 		case MsgSvcBindingUpdate:
 			return handleMsgSvcBindUpdate(ctx, k, msg)
+		case MsgSvcSetPricing:
+			return handleMsgSvcSetPricing(ctx, k, msg)
 		case MsgSvcDisable:
 			return handleMsgSvcDisable(ctx, k, msg)
 		case MsgSvcEnable:
@@ -24,8 +33,16 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSvcRefundDeposit(ctx, k, msg)
 		case MsgSvcRequest:
 			return handleMsgSvcRequest(ctx, k, msg)
+		case MsgSvcMulticastRequest:
+			return handleMsgSvcMulticastRequest(ctx, k, msg)
 		case MsgSvcResponse:
 			return handleMsgSvcResponse(ctx, k, msg)
+		case MsgSvcCancelRequest:
+			return handleMsgSvcCancelRequest(ctx, k, msg)
+		case MsgSvcSubscribe:
+			return handleMsgSvcSubscribe(ctx, k, msg)
+		case MsgSvcCancelSubscription:
+			return handleMsgSvcCancelSubscription(ctx, k, msg)
 		case MsgSvcRefundFees:
 			return handleMsgSvcRefundFees(ctx, k, msg)
 		case MsgSvcWithdrawFees:
@@ -51,9 +68,37 @@ func handleMsgSvcDef(ctx sdk.Context, k Keeper, msg MsgSvcDef) sdk.Result {
 	return sdk.Result{}
 }
 
+func handleMsgSvcDefVersion(ctx sdk.Context, k Keeper, msg MsgSvcDefVersion) sdk.Result {
+	svcDef, found := k.GetServiceDefinition(ctx, msg.ChainId, msg.Name)
+	if !found {
+		return ErrSvcDefNotExists(k.Codespace(), msg.ChainId, msg.Name).Result()
+	}
+	if !svcDef.Author.Equals(msg.Author) {
+		return ErrNotMatchingAuthor(k.Codespace(), msg.Author).Result()
+	}
+	version, err := k.AddServiceDefinitionVersion(ctx, msg.SvcDef)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Create service definition version", "name", msg.Name, "version", version, "author", msg.Author.String())
+	return sdk.Result{
+		Tags: sdk.NewTags(tags.DefVersion, []byte(strconv.FormatUint(version, 10))),
+	}
+}
+
+func handleMsgSvcDefDeprecate(ctx sdk.Context, k Keeper, msg MsgSvcDefDeprecate) sdk.Result {
+	err := k.DeprecateServiceDefinition(ctx, msg.DefChainID, msg.DefName, msg.Version, msg.Author)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Deprecate service definition", "name", msg.DefName, "version", msg.Version, "author", msg.Author.String())
+	return sdk.Result{}
+}
+
 func handleMsgSvcBind(ctx sdk.Context, k Keeper, msg MsgSvcBind) sdk.Result {
 	svcBinding := NewSvcBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider, msg.BindingType,
 		msg.Deposit, msg.Prices, msg.Level, true)
+	svcBinding.DefVersion = msg.DefVersion
 	err := k.AddServiceBinding(ctx, svcBinding)
 	if err != nil {
 		return err.Result()
@@ -75,6 +120,16 @@ func handleMsgSvcBindUpdate(ctx sdk.Context, k Keeper, msg MsgSvcBindingUpdate)
 	return sdk.Result{}
 }
 
+func handleMsgSvcSetPricing(ctx sdk.Context, k Keeper, msg MsgSvcSetPricing) sdk.Result {
+	err := k.SetBindingPricing(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider, msg.Pricing)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Set service binding pricing", "def_name", msg.DefName, "def_chain_id", msg.DefChainID,
+		"provider", msg.Provider.String(), "tiers", len(msg.Pricing.Tiers), "consumers", len(msg.Pricing.Consumers))
+	return sdk.Result{}
+}
+
 func handleMsgSvcDisable(ctx sdk.Context, k Keeper, msg MsgSvcDisable) sdk.Result {
 	err := k.Disable(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider)
 	if err != nil {
@@ -106,40 +161,14 @@ func handleMsgSvcRefundDeposit(ctx sdk.Context, k Keeper, msg MsgSvcRefundDeposi
 }
 
 func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Result {
-	bind, bindingFound := k.GetServiceBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider)
-	if !bindingFound {
-		return ErrSvcBindingNotExists(k.Codespace()).Result()
-	}
-	if !bind.Available {
-		return ErrSvcBindingNotAvailable(k.Codespace()).Result()
-	}
-
-	_, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
-	if !methodFound {
-		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
-	}
-
 	if msg.Profiling {
 		if _, found := k.gk.GetProfiler(ctx, msg.Consumer); !found {
 			return ErrNotProfiler(k.Codespace(), msg.Consumer).Result()
 		}
 	}
 
-	//Method id start at 1
-	if len(bind.Prices) >= int(msg.MethodID) && !msg.ServiceFee.IsAllGTE(sdk.Coins{bind.Prices[msg.MethodID-1]}) {
-		return ErrLtServiceFee(k.Codespace(), sdk.Coins{bind.Prices[msg.MethodID-1]}).Result()
-	}
-
-	request := NewSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
-
-	// request service fee is equal to service binding service fee if not profiling
-	if len(bind.Prices) >= int(msg.MethodID) && !msg.Profiling {
-		request.ServiceFee = sdk.Coins{bind.Prices[msg.MethodID-1]}
-	} else {
-		request.ServiceFee = nil
-	}
-
-	request, err := k.AddRequest(ctx, request)
+	request, err := addRequest(ctx, k, msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer,
+		msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
 	if err != nil {
 		return err.Result()
 	}
@@ -159,6 +188,149 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 	}
 }
 
+func handleMsgSvcMulticastRequest(ctx sdk.Context, k Keeper, msg MsgSvcMulticastRequest) sdk.Result {
+	if msg.Profiling {
+		if _, found := k.gk.GetProfiler(ctx, msg.Consumer); !found {
+			return ErrNotProfiler(k.Codespace(), msg.Consumer).Result()
+		}
+	}
+
+	providers := msg.Providers
+	if len(providers) == 0 {
+		bindings, err := k.SelectMulticastBindings(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Consumer,
+			msg.MethodID, msg.ServiceFee, msg.Profiling, int(msg.ProviderCount))
+		if err != nil {
+			return err.Result()
+		}
+		for _, binding := range bindings {
+			providers = append(providers, binding.Provider)
+		}
+	}
+
+	mreq := NewMulticastRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.MethodID)
+	mreq.RequestHeight = ctx.BlockHeight()
+	mreq.RequestIntraTxCounter = k.GetIntraTxCounter(ctx)
+
+	resTags := sdk.NewTags(
+		tags.Consumer, []byte(msg.Consumer.String()),
+	)
+
+	// the service fee is escrowed for each provider separately
+	for _, provider := range providers {
+		request, err := addRequest(ctx, k, msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer,
+			provider, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
+		if err != nil {
+			return err.Result()
+		}
+
+		mreq.Providers = append(mreq.Providers, provider)
+		mreq.RequestIDs = append(mreq.RequestIDs, request.RequestID())
+
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.RequestID, []byte(request.RequestID()),
+			tags.Provider, []byte(request.Provider.String()),
+			tags.ServiceFee, []byte(request.ServiceFee.String()),
+		))
+	}
+
+	k.SetMulticastRequest(ctx, mreq)
+
+	ctx.Logger().Debug("Service multicast request", "def_name", msg.DefName, "def_chain_id", msg.DefChainID,
+		"consumer", msg.Consumer.String(), "method_id", msg.MethodID, "providers", len(providers),
+		"multicast_request_id", mreq.MulticastRequestID())
+
+	resTags = resTags.AppendTag(tags.MulticastRequestID, []byte(mreq.MulticastRequestID()))
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// check the binding and the service fee, then add a request of the service method to the given provider
+func addRequest(ctx sdk.Context, k Keeper, defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress,
+	methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) (SvcRequest, sdk.Error) {
+	request, bind, err := newRequest(ctx, k, defChainID, defName, bindChainID, reqChainID, consumer, provider, methodID, input, serviceFee, profiling)
+	if err != nil {
+		return request, err
+	}
+
+	request, err = k.AddRequest(ctx, request)
+	if err != nil {
+		return request, err
+	}
+
+	recordConsumerVolume(ctx, k, bind, consumer)
+	return request, nil
+}
+
+// check the binding and the service fee, then build a request with the effective service fee
+func newRequest(ctx sdk.Context, k Keeper, defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress,
+	methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) (SvcRequest, SvcBinding, sdk.Error) {
+	bind, bindingFound := k.GetServiceBinding(ctx, defChainID, defName, bindChainID, provider)
+	if !bindingFound {
+		return SvcRequest{}, bind, ErrSvcBindingNotExists(k.Codespace())
+	}
+	if !bind.Available {
+		return SvcRequest{}, bind, ErrSvcBindingNotAvailable(k.Codespace())
+	}
+
+	method, methodFound := k.GetVersionMethod(ctx, defChainID, defName, bind.DefVersion, methodID)
+	if !methodFound {
+		return SvcRequest{}, bind, ErrMethodNotExists(k.Codespace(), methodID)
+	}
+	if err := method.RequestDescriptor.Validate(input); err != nil {
+		return SvcRequest{}, bind, ErrInvalidPayload(k.Codespace(), err.Error())
+	}
+
+	// the prices after applying the pricing rules of the binding
+	prices := k.GetEffectivePrices(ctx, bind, consumer)
+
+	//Method id start at 1
+	if len(prices) >= int(methodID) && !serviceFee.IsAllGTE(sdk.Coins{prices[methodID-1]}) {
+		return SvcRequest{}, bind, ErrLtServiceFee(k.Codespace(), sdk.Coins{prices[methodID-1]})
+	}
+
+	request := NewSvcRequest(defChainID, defName, bindChainID, reqChainID, consumer, provider, methodID, input, serviceFee, profiling)
+	request.DefVersion = bind.DefVersion
+
+	// request service fee is equal to the effective price if not profiling
+	if len(prices) >= int(methodID) && !profiling {
+		request.ServiceFee = sdk.Coins{prices[methodID-1]}
+	} else {
+		request.ServiceFee = nil
+	}
+	return request, bind, nil
+}
+
+// record the request for the volume tiers of the binding
+func recordConsumerVolume(ctx sdk.Context, k Keeper, bind SvcBinding, consumer sdk.AccAddress) {
+	if pricing := k.GetBindingPricing(ctx, bind.DefChainID, bind.DefName, bind.BindChainID, bind.Provider); len(pricing.Tiers) > 0 {
+		k.RecordConsumerVolume(ctx, bind, consumer, pricing.Window)
+	}
+}
+
+// send the next request of a subscription with the service fee paid from the escrow
+func addSubscriptionRequest(ctx sdk.Context, k Keeper, sub Subscription) (Subscription, SvcRequest, sdk.Error) {
+	request, bind, err := newRequest(ctx, k, sub.DefChainID, sub.DefName, sub.BindChainID, sub.ReqChainID, sub.Consumer,
+		sub.Provider, sub.MethodID, sub.Input, sub.ServiceFee, false)
+	if err != nil {
+		return sub, request, err
+	}
+	if !sub.Escrow.IsAllGTE(request.ServiceFee) {
+		return sub, request, ErrInvalidSubscription(k.Codespace(), fmt.Sprintf("escrow %s is less than the service fee %s", sub.Escrow, request.ServiceFee))
+	}
+
+	request, err = k.AddEscrowedRequest(ctx, request)
+	if err != nil {
+		return sub, request, err
+	}
+	recordConsumerVolume(ctx, k, bind, sub.Consumer)
+
+	sub.Escrow = sub.Escrow.Sub(request.ServiceFee)
+	sub.Repetitions++
+	sub.LastRequestID = request.RequestID()
+	return sub, request, nil
+}
+
 func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Result {
 	eHeight, rHeight, counter, _ := ConvertRequestID(msg.RequestID)
 	request, found := k.GetActiveRequest(ctx, eHeight, rHeight, counter)
@@ -178,11 +350,22 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	response := NewSvcResponse(msg.ReqChainID, eHeight, rHeight, counter, msg.Provider,
 		request.Consumer, msg.Output, msg.ErrorMsg)
 
+	method, _ := k.GetVersionMethod(ctx, request.DefChainID, request.DefName, request.DefVersion, request.MethodID)
+	if method.OutputPrivacy == PubKeyEncryption && len(msg.Output) > 0 {
+		if err := validateEncryptedOutput(msg.Output); err != nil {
+			return ErrInvalidEncryptedOutput(k.Codespace(), err.Error()).Result()
+		}
+		response.Encryption = EncryptionSchemeECIES
+	} else if err := method.ResponseDescriptor.Validate(msg.Output); err != nil {
+		// the encrypted outputs can only be validated by the consumer
+		return ErrInvalidPayload(k.Codespace(), err.Error()).Result()
+	}
+
 	k.AddResponse(ctx, response)
 
 	// delete request from active request list and expiration list
-	k.DeleteActiveRequest(ctx, request)
-	k.DeleteRequestExpiration(ctx, request)
+	k.CloseRequest(ctx, request)
+	k.RecordServedRequest(ctx, request)
 
 	err := k.AddIncomingFee(ctx, response.Provider, request.ServiceFee)
 	if err != nil {
@@ -201,6 +384,71 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	}
 }
 
+func handleMsgSvcCancelRequest(ctx sdk.Context, k Keeper, msg MsgSvcCancelRequest) sdk.Result {
+	eHeight, rHeight, counter, _ := ConvertRequestID(msg.RequestID)
+	request, err := k.CancelRequest(ctx, eHeight, rHeight, counter, msg.Consumer)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Debug("Cancel service request", "request_id", request.RequestID(),
+		"consumer", request.Consumer.String(), "service_fee", request.ServiceFee.String())
+
+	resTags := sdk.NewTags(
+		tags.RequestID, []byte(request.RequestID()),
+		tags.Consumer, []byte(request.Consumer.String()),
+		tags.Provider, []byte(request.Provider.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSvcSubscribe(ctx sdk.Context, k Keeper, msg MsgSvcSubscribe) sdk.Result {
+	// check the binding and the service fee before escrowing
+	_, _, err := newRequest(ctx, k, msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer,
+		msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, false)
+	if err != nil {
+		return err.Result()
+	}
+
+	sub := NewSubscription(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider,
+		msg.MethodID, msg.Input, msg.ServiceFee, msg.Interval, msg.MaxRepetitions, msg.Escrow)
+	sub, err = k.AddSubscription(ctx, sub)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.Logger().Debug("Service subscription", "def_name", msg.DefName, "def_chain_id", msg.DefChainID,
+		"provider", msg.Provider.String(), "consumer", msg.Consumer.String(), "method_id", msg.MethodID,
+		"interval", msg.Interval, "escrow", msg.Escrow, "subscription_id", sub.ID)
+
+	resTags := sdk.NewTags(
+		tags.SubscriptionID, []byte(strconv.FormatUint(sub.ID, 10)),
+		tags.Provider, []byte(sub.Provider.String()),
+		tags.Consumer, []byte(sub.Consumer.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSvcCancelSubscription(ctx sdk.Context, k Keeper, msg MsgSvcCancelSubscription) sdk.Result {
+	sub, err := k.CancelSubscription(ctx, msg.SubscriptionID, msg.Consumer)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Debug("Cancel service subscription", "subscription_id", sub.ID,
+		"consumer", sub.Consumer.String(), "escrow", sub.Escrow.String())
+
+	resTags := sdk.NewTags(
+		tags.SubscriptionID, []byte(strconv.FormatUint(sub.ID, 10)),
+		tags.Consumer, []byte(sub.Consumer.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
 func handleMsgSvcRefundFees(ctx sdk.Context, k Keeper, msg MsgSvcRefundFees) sdk.Result {
 	err := k.RefundFee(ctx, msg.Consumer)
 	if err != nil {
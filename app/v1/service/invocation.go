@@ -8,6 +8,9 @@ import (
 	"strings"
 )
 
+// the maximum number of requests and responses kept in the history of a consumer
+const MaxConsumerHistory = 1000
+
 type SvcRequest struct {
 	DefChainID            string         `json:"def_chain_id"`
 	DefName               string         `json:"def_name"`
@@ -22,6 +25,7 @@ type SvcRequest struct {
 	RequestHeight         int64          `json:"request_height"`           // block height of service request
 	RequestIntraTxCounter int16          `json:"request_intra_tx_counter"` // block-local tx index of service request
 	ExpirationHeight      int64          `json:"expiration_height"`        // block height of the service request has expired
+	DefVersion            uint64         `json:"def_version"`              // version of the service definition pinned by the binding
 }
 
 func NewSvcRequest(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) SvcRequest {
@@ -73,6 +77,7 @@ type SvcResponse struct {
 	Consumer              sdk.AccAddress `json:"consumer"`
 	Output                []byte         `json:"output"`
 	ErrorMsg              []byte         `json:"error_msg"`
+	Encryption            string         `json:"encryption"` // the scheme the output is encrypted with, empty if not encrypted
 }
 
 func NewSvcResponse(reqChainID string, eheight int64, rheight int64, counter int16, provider, consumer sdk.AccAddress, out []byte, errorMsg []byte) SvcResponse {
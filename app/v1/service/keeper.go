@@ -1,6 +1,7 @@
 package service
 
 import (
+	"encoding/binary"
 	"fmt"
 	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/bank"
@@ -9,6 +10,7 @@ import (
 	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/tools/protoidl"
 	sdk "github.com/irisnet/irishub/types"
+	"math"
 	"time"
 )
 
@@ -100,6 +102,106 @@ func (k Keeper) GetMethods(ctx sdk.Context, chainId, name string) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, GetMethodsSubspaceKey(chainId, name))
 }
 
+// AddServiceDefinitionVersion adds a new version of the service definition with its methods.
+// The definitions before versioning are of version 1
+func (k Keeper) AddServiceDefinitionVersion(ctx sdk.Context, svcDef SvcDef) (uint64, sdk.Error) {
+	version := k.GetLatestVersion(ctx, svcDef.ChainId, svcDef.Name) + 1
+
+	methods, err := protoidl.GetMethods(svcDef.IDLContent)
+	if err != nil {
+		return 0, ErrInvalidIDL(k.Codespace(), err.Error())
+	}
+	kvStore := ctx.KVStore(k.storeKey)
+	for index, method := range methods {
+		methodProperty, err := methodToMethodProperty(index+1, method)
+		if err != nil {
+			return 0, err
+		}
+		methodBytes := k.cdc.MustMarshalBinaryLengthPrefixed(methodProperty)
+		kvStore.Set(GetVersionMethodPropertyKey(svcDef.ChainId, svcDef.Name, version, methodProperty.ID), methodBytes)
+	}
+
+	kvStore.Set(GetServiceDefinitionVersionKey(svcDef.ChainId, svcDef.Name, version), k.cdc.MustMarshalBinaryLengthPrefixed(svcDef))
+	kvStore.Set(GetLatestDefinitionVersionKey(svcDef.ChainId, svcDef.Name), k.cdc.MustMarshalBinaryLengthPrefixed(version))
+	return version, nil
+}
+
+// GetLatestVersion returns the latest version of the service definition, or 1 if it has never been upgraded
+func (k Keeper) GetLatestVersion(ctx sdk.Context, chainId, name string) (version uint64) {
+	kvStore := ctx.KVStore(k.storeKey)
+	bz := kvStore.Get(GetLatestDefinitionVersionKey(chainId, name))
+	if bz == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &version)
+	return version
+}
+
+// GetServiceDefinitionVersion returns the given version of the service definition, version 0 refers to the latest version
+func (k Keeper) GetServiceDefinitionVersion(ctx sdk.Context, chainId, name string, version uint64) (svcDef SvcDef, found bool) {
+	if version == 0 {
+		version = k.GetLatestVersion(ctx, chainId, name)
+	}
+	if version == 1 {
+		return k.GetServiceDefinition(ctx, chainId, name)
+	}
+
+	kvStore := ctx.KVStore(k.storeKey)
+	bz := kvStore.Get(GetServiceDefinitionVersionKey(chainId, name, version))
+	if bz == nil {
+		return svcDef, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &svcDef)
+	return svcDef, true
+}
+
+// Gets the method in a specific version of the service and methodID
+func (k Keeper) GetVersionMethod(ctx sdk.Context, chainId, name string, version uint64, id int16) (method MethodProperty, found bool) {
+	if version <= 1 {
+		return k.GetMethod(ctx, chainId, name, id)
+	}
+	store := ctx.KVStore(k.storeKey)
+	methodBytes := store.Get(GetVersionMethodPropertyKey(chainId, name, version, id))
+	if methodBytes != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(methodBytes, &method)
+		return method, true
+	}
+	return method, false
+}
+
+// Gets all the methods in a specific version of the service
+func (k Keeper) GetVersionMethods(ctx sdk.Context, chainId, name string, version uint64) sdk.Iterator {
+	if version <= 1 {
+		return k.GetMethods(ctx, chainId, name)
+	}
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetVersionMethodsSubspaceKey(chainId, name, version))
+}
+
+// DeprecateServiceDefinition marks the version of the service definition deprecated, no new binding to it is allowed
+func (k Keeper) DeprecateServiceDefinition(ctx sdk.Context, chainId, name string, version uint64, author sdk.AccAddress) sdk.Error {
+	svcDef, found := k.GetServiceDefinitionVersion(ctx, chainId, name, version)
+	if !found {
+		return ErrSvcDefVersionNotExists(k.Codespace(), chainId, name, version)
+	}
+	if !svcDef.Author.Equals(author) {
+		return ErrNotMatchingAuthor(k.Codespace(), author)
+	}
+	if k.IsDeprecated(ctx, chainId, name, version) {
+		return ErrSvcDefDeprecated(k.Codespace(), chainId, name, version)
+	}
+
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Set(GetDeprecatedDefinitionKey(chainId, name, version), []byte{0x01})
+	return nil
+}
+
+// IsDeprecated returns true if the version of the service definition is deprecated
+func (k Keeper) IsDeprecated(ctx sdk.Context, chainId, name string, version uint64) bool {
+	kvStore := ctx.KVStore(k.storeKey)
+	return kvStore.Has(GetDeprecatedDefinitionKey(chainId, name, version))
+}
+
 func (k Keeper) AddServiceBinding(ctx sdk.Context, svcBinding SvcBinding) sdk.Error {
 	kvStore := ctx.KVStore(k.storeKey)
 	_, found := k.GetServiceDefinition(ctx, svcBinding.DefChainID, svcBinding.DefName)
@@ -107,6 +209,17 @@ func (k Keeper) AddServiceBinding(ctx sdk.Context, svcBinding SvcBinding) sdk.Er
 		return ErrSvcDefNotExists(k.Codespace(), svcBinding.DefChainID, svcBinding.DefName)
 	}
 
+	// bind to the latest version if not specified
+	if svcBinding.DefVersion == 0 {
+		svcBinding.DefVersion = k.GetLatestVersion(ctx, svcBinding.DefChainID, svcBinding.DefName)
+	}
+	if _, found := k.GetServiceDefinitionVersion(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.DefVersion); !found {
+		return ErrSvcDefVersionNotExists(k.Codespace(), svcBinding.DefChainID, svcBinding.DefName, svcBinding.DefVersion)
+	}
+	if k.IsDeprecated(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.DefVersion) {
+		return ErrSvcDefDeprecated(k.Codespace(), svcBinding.DefChainID, svcBinding.DefName, svcBinding.DefVersion)
+	}
+
 	_, found = k.GetServiceBinding(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.BindChainID, svcBinding.Provider)
 	if found {
 		return ErrSvcBindingExists(k.Codespace())
@@ -162,6 +275,9 @@ func (k Keeper) UpdateServiceBinding(ctx sdk.Context, svcBinding SvcBinding) sdk
 		return ErrSvcBindingNotExists(k.Codespace())
 	}
 
+	// the version is pinned by the binding
+	svcBinding.DefVersion = oldBinding.DefVersion
+
 	if len(svcBinding.Prices) > 0 {
 		err := k.validateMethodPrices(ctx, svcBinding)
 		if err != nil {
@@ -296,11 +412,14 @@ func (k Keeper) RefundDeposit(ctx sdk.Context, defChainID, defName, bindChainID
 
 	svcBindingBytes := k.cdc.MustMarshalBinaryLengthPrefixed(binding)
 	kvStore.Set(GetServiceBindingKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider), svcBindingBytes)
+
+	// the binding is retired once its deposit is refunded, the service history starts over if it is enabled again
+	k.DeleteBindingStats(ctx, binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider)
 	return nil
 }
 
 func (k Keeper) validateMethodPrices(ctx sdk.Context, svcBinding SvcBinding) sdk.Error {
-	iterator := k.GetMethods(ctx, svcBinding.DefChainID, svcBinding.DefName)
+	iterator := k.GetVersionMethods(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.DefVersion)
 	defer iterator.Close()
 	var methods []MethodProperty
 	for ; iterator.Valid(); iterator.Next() {
@@ -315,9 +434,151 @@ func (k Keeper) validateMethodPrices(ctx sdk.Context, svcBinding SvcBinding) sdk
 	return nil
 }
 
+func (k Keeper) SetBindingStats(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress, stats BindingStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(stats)
+	store.Set(GetBindingStatsKey(defChainID, defName, bindChainID, provider), bz)
+}
+
+// Returns the service history of a binding, empty if no request has been finished
+func (k Keeper) GetBindingStats(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) (stats BindingStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetBindingStatsKey(defChainID, defName, bindChainID, provider))
+	if bz == nil {
+		return stats
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stats)
+	return stats
+}
+
+func (k Keeper) DeleteBindingStats(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetBindingStatsKey(defChainID, defName, bindChainID, provider))
+}
+
+// Record a request responded by the provider of the binding
+func (k Keeper) RecordServedRequest(ctx sdk.Context, req SvcRequest) {
+	stats := k.GetBindingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	stats.ServedRequests++
+	stats.TotalResponseHeights += uint64(ctx.BlockHeight() - req.RequestHeight)
+	k.SetBindingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider, stats)
+}
+
+// Record a request timed out and the deposit slashed from the binding
+func (k Keeper) RecordTimeoutRequest(ctx sdk.Context, req SvcRequest, slashCoins sdk.Coins) {
+	stats := k.GetBindingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	stats.TimeoutRequests++
+	stats.SlashedCoins = stats.SlashedCoins.Add(slashCoins)
+	k.SetBindingStats(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider, stats)
+}
+
+// Set the pricing rules of a binding, empty rules remove the existing ones
+func (k Keeper) SetBindingPricing(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress, pricing Pricing) sdk.Error {
+	binding, found := k.GetServiceBinding(ctx, defChainID, defName, bindChainID, provider)
+	if !found {
+		return ErrSvcBindingNotExists(k.Codespace())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if pricing.Empty() {
+		store.Delete(GetBindingPricingKey(defChainID, defName, bindChainID, provider))
+		return nil
+	}
+
+	for _, c := range pricing.Consumers {
+		if len(c.Prices) != len(binding.Prices) {
+			return ErrInvalidPriceCount(k.Codespace(), len(c.Prices), len(binding.Prices))
+		}
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(pricing)
+	store.Set(GetBindingPricingKey(defChainID, defName, bindChainID, provider), bz)
+	return nil
+}
+
+// Returns the pricing rules of a binding, empty if not set
+func (k Keeper) GetBindingPricing(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) (pricing Pricing) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetBindingPricingKey(defChainID, defName, bindChainID, provider))
+	if bz == nil {
+		return pricing
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pricing)
+	return pricing
+}
+
+// Returns the number of requests made by a consumer to a binding in the last window blocks
+func (k Keeper) GetConsumerVolume(ctx sdk.Context, binding SvcBinding, consumer sdk.AccAddress, window int64) (volume uint64) {
+	prefix := GetConsumerVolumeSubspaceKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider, consumer)
+	start := GetConsumerVolumeKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider, consumer, windowStart(ctx, window))
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var count uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &count)
+		volume += count
+	}
+	return volume
+}
+
+// Record a request made by a consumer to a binding, the records out of the window are removed
+func (k Keeper) RecordConsumerVolume(ctx sdk.Context, binding SvcBinding, consumer sdk.AccAddress, window int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetConsumerVolumeSubspaceKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider, consumer)
+	end := GetConsumerVolumeKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider, consumer, windowStart(ctx, window))
+
+	iterator := store.Iterator(prefix, end)
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	key := GetConsumerVolumeKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider, consumer, ctx.BlockHeight())
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(count+1))
+}
+
+// the first block height in the window ending at the current block
+func windowStart(ctx sdk.Context, window int64) int64 {
+	if start := ctx.BlockHeight() - window + 1; start > 0 {
+		return start
+	}
+	return 0
+}
+
+// Returns the prices of all methods of a binding for a consumer after applying the pricing rules
+func (k Keeper) GetEffectivePrices(ctx sdk.Context, binding SvcBinding, consumer sdk.AccAddress) []sdk.Coin {
+	pricing := k.GetBindingPricing(ctx, binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider)
+	if pricing.Empty() {
+		return binding.Prices
+	}
+	var volume uint64
+	if len(pricing.Tiers) > 0 {
+		volume = k.GetConsumerVolume(ctx, binding, consumer, pricing.Window)
+	}
+	return pricing.EffectivePrices(binding.Prices, consumer, volume)
+}
+
 //__________________________________________________________________________
 
 func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Error) {
+	return k.addRequest(ctx, req, false)
+}
+
+// Add a request whose service fee has been escrowed in the service request account
+func (k Keeper) AddEscrowedRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Error) {
+	return k.addRequest(ctx, req, true)
+}
+
+func (k Keeper) addRequest(ctx sdk.Context, req SvcRequest, escrowed bool) (SvcRequest, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 
 	counter := k.GetIntraTxCounter(ctx)
@@ -332,10 +593,14 @@ func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Err
 
 	store.Set(GetRequestKey(req.DefChainID, req.DefName, req.BindChainID, req.Provider,
 		req.RequestHeight, req.RequestIntraTxCounter), bz)
+	k.addConsumerHistory(ctx, GetConsumerRequestsSubspaceKey(req.Consumer),
+		GetConsumerRequestKey(req.Consumer, req.RequestHeight, req.RequestIntraTxCounter), bz)
 
-	_, err := k.ck.SendCoins(ctx, req.Consumer, auth.ServiceRequestCoinsAccAddr, req.ServiceFee)
-	if err != nil {
-		return req, err
+	if !escrowed {
+		_, err := k.ck.SendCoins(ctx, req.Consumer, auth.ServiceRequestCoinsAccAddr, req.ServiceFee)
+		if err != nil {
+			return req, err
+		}
 	}
 	k.AddActiveRequest(ctx, req)
 	k.AddRequestExpiration(ctx, req)
@@ -356,6 +621,13 @@ func (k Keeper) DeleteActiveRequest(ctx sdk.Context, req SvcRequest) {
 		req.RequestHeight, req.RequestIntraTxCounter))
 }
 
+// Remove a request from the active request queue once it is responded, timed out or cancelled
+func (k Keeper) CloseRequest(ctx sdk.Context, req SvcRequest) {
+	k.DeleteActiveRequest(ctx, req)
+	k.DeleteRequestExpiration(ctx, req)
+	k.closeMulticastRequest(ctx, req)
+}
+
 func (k Keeper) AddRequestExpiration(ctx sdk.Context, req SvcRequest) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(req)
@@ -377,6 +649,271 @@ func (k Keeper) GetActiveRequest(ctx sdk.Context, eHeight, rHeight int64, counte
 	return req, true
 }
 
+// Cancel an active request of a particular consumer before it is responded, the service fee will be added to the return fee
+func (k Keeper) CancelRequest(ctx sdk.Context, eHeight, rHeight int64, counter int16, consumer sdk.AccAddress) (SvcRequest, sdk.Error) {
+	req, found := k.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	if !found {
+		req.ExpirationHeight = eHeight
+		req.RequestHeight = rHeight
+		req.RequestIntraTxCounter = counter
+		return req, ErrRequestNotActive(k.Codespace(), req.RequestID())
+	}
+	if !consumer.Equals(req.Consumer) {
+		return req, ErrNotMatchingConsumer(k.Codespace(), consumer)
+	}
+
+	k.AddReturnFee(ctx, req.Consumer, req.ServiceFee)
+
+	k.CloseRequest(ctx, req)
+	k.metrics.ActiveRequests.Add(-1)
+	return req, nil
+}
+
+// Returns the requests of a particular consumer by page, in the order of request height
+func (k Keeper) GetConsumerRequests(ctx sdk.Context, consumer sdk.AccAddress, page uint64, size uint16) (requests []SvcRequest) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetConsumerRequestsSubspaceKey(consumer))
+	defer iterator.Close()
+
+	skip := sdk.GetSkipCount(page, size)
+	requests = make([]SvcRequest, 0, size)
+	for i := 0; iterator.Valid() && i < int(skip)+int(size); iterator.Next() {
+		if i >= int(skip) {
+			var request SvcRequest
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &request)
+			requests = append(requests, request)
+		}
+		i++
+	}
+	return requests
+}
+
+// Add an entry to a history of a consumer, the oldest entry is deleted once the history exceeds MaxConsumerHistory
+func (k Keeper) addConsumerHistory(ctx sdk.Context, historyPrefix []byte, key []byte, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+
+	var count uint64
+	countKey := GetConsumerHistoryCountKey(historyPrefix)
+	if countBz := store.Get(countKey); countBz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(countBz, &count)
+	}
+	count++
+
+	if count > MaxConsumerHistory {
+		iterator := sdk.KVStorePrefixIterator(store, historyPrefix)
+		var oldest []byte
+		if iterator.Valid() {
+			oldest = iterator.Key()
+		}
+		iterator.Close()
+		if oldest != nil {
+			store.Delete(oldest)
+			count--
+		}
+	}
+	store.Set(countKey, k.cdc.MustMarshalBinaryLengthPrefixed(count))
+}
+
+// Select the first count available bindings of a service method whose prices for the consumer are not greater than the given service fee
+func (k Keeper) SelectMulticastBindings(ctx sdk.Context, defChainID, defName, bindChainID string, consumer sdk.AccAddress, methodID int16, serviceFee sdk.Coins, profiling bool, count int) ([]SvcBinding, sdk.Error) {
+	iterator := k.ServiceBindingsIterator(ctx, defChainID, defName)
+	defer iterator.Close()
+
+	var bindings []SvcBinding
+	for ; iterator.Valid() && len(bindings) < count; iterator.Next() {
+		var binding SvcBinding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &binding)
+
+		if binding.BindChainID != bindChainID || !binding.Available {
+			continue
+		}
+		if !profiling {
+			prices := k.GetEffectivePrices(ctx, binding, consumer)
+			if len(prices) >= int(methodID) && !serviceFee.IsAllGTE(sdk.Coins{prices[methodID-1]}) {
+				continue
+			}
+		}
+		bindings = append(bindings, binding)
+	}
+
+	if len(bindings) < count {
+		return nil, ErrInvalidMulticast(k.Codespace(), fmt.Sprintf("only %d available bindings found, %d required", len(bindings), count))
+	}
+	return bindings, nil
+}
+
+// Set a multicast request and index it by each of its requests
+func (k Keeper) SetMulticastRequest(ctx sdk.Context, mreq MulticastRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(mreq)
+	key := GetMulticastRequestKey(mreq.RequestHeight, mreq.RequestIntraTxCounter)
+	store.Set(key, bz)
+	for _, requestID := range mreq.RequestIDs {
+		if _, rHeight, counter, err := ConvertRequestID(requestID); err == nil {
+			store.Set(GetMulticastRequestIndexKey(rHeight, counter), key)
+		}
+	}
+}
+
+// Delete a multicast request once none of its requests is active
+func (k Keeper) closeMulticastRequest(ctx sdk.Context, req SvcRequest) {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(GetMulticastRequestIndexKey(req.RequestHeight, req.RequestIntraTxCounter))
+	if key == nil {
+		return
+	}
+	value := store.Get(key)
+	if value == nil {
+		return
+	}
+	var mreq MulticastRequest
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &mreq)
+	if AggregateMulticastStatus(k.GetMulticastRequestStates(ctx, mreq)) == MulticastStatusPending {
+		return
+	}
+
+	store.Delete(key)
+	for _, requestID := range mreq.RequestIDs {
+		if _, rHeight, counter, err := ConvertRequestID(requestID); err == nil {
+			store.Delete(GetMulticastRequestIndexKey(rHeight, counter))
+		}
+	}
+}
+
+func (k Keeper) GetMulticastRequest(ctx sdk.Context, rHeight int64, counter int16) (mreq MulticastRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetMulticastRequestKey(rHeight, counter))
+	if value == nil {
+		return mreq, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &mreq)
+	return mreq, true
+}
+
+// Add a subscription with the escrow transferred from the consumer, the first request is sent at the end of the current block
+func (k Keeper) AddSubscription(ctx sdk.Context, sub Subscription) (Subscription, sdk.Error) {
+	// the height of the next request must not overflow
+	if maxInterval := math.MaxInt64 - ctx.BlockHeight(); sub.Interval > uint64(maxInterval) {
+		return sub, ErrInvalidSubscription(k.Codespace(), fmt.Sprintf("interval must not be greater than %d", maxInterval))
+	}
+
+	_, err := k.ck.SendCoins(ctx, sub.Consumer, auth.ServiceRequestCoinsAccAddr, sub.Escrow)
+	if err != nil {
+		return sub, err
+	}
+
+	sub.ID = k.getNextSubscriptionID(ctx)
+	sub.NextHeight = ctx.BlockHeight()
+	k.SetSubscription(ctx, sub)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetConsumerSubscriptionKey(sub.Consumer, sub.ID), []byte{})
+	store.Set(GetSubscriptionQueueKey(sub.NextHeight, sub.ID), []byte{})
+	return sub, nil
+}
+
+func (k Keeper) getNextSubscriptionID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nextSubscriptionIDKey)
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	}
+	store.Set(nextSubscriptionIDKey, k.cdc.MustMarshalBinaryLengthPrefixed(id+1))
+	return id + 1
+}
+
+func (k Keeper) SetSubscription(ctx sdk.Context, sub Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(sub)
+	store.Set(GetSubscriptionKey(sub.ID), bz)
+}
+
+func (k Keeper) GetSubscription(ctx sdk.Context, id uint64) (sub Subscription, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetSubscriptionKey(id))
+	if bz == nil {
+		return sub, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sub)
+	return sub, true
+}
+
+// Returns all active subscriptions of a consumer
+func (k Keeper) GetConsumerSubscriptions(ctx sdk.Context, consumer sdk.AccAddress) (subs []Subscription) {
+	prefix := GetConsumerSubscriptionsSubspaceKey(consumer)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+		if sub, found := k.GetSubscription(ctx, id); found {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// Returns an iterator for the subscriptions whose next request is not later than the given height
+func (k Keeper) SubscriptionQueueIterator(ctx sdk.Context, height int64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(subscriptionQueueKey, GetSubscriptionQueuePrefix(height+1))
+}
+
+// Reschedule the subscription after a request is sent
+func (k Keeper) RescheduleSubscription(ctx sdk.Context, sub Subscription) Subscription {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSubscriptionQueueKey(sub.NextHeight, sub.ID))
+	sub.NextHeight = ctx.BlockHeight() + int64(sub.Interval)
+	store.Set(GetSubscriptionQueueKey(sub.NextHeight, sub.ID), []byte{})
+	k.SetSubscription(ctx, sub)
+	return sub
+}
+
+// Remove the subscription and refund the escrow left to the consumer
+func (k Keeper) CloseSubscription(ctx sdk.Context, sub Subscription) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSubscriptionKey(sub.ID))
+	store.Delete(GetSubscriptionQueueKey(sub.NextHeight, sub.ID))
+	store.Delete(GetConsumerSubscriptionKey(sub.Consumer, sub.ID))
+
+	_, err := k.ck.SendCoins(ctx, auth.ServiceRequestCoinsAccAddr, sub.Consumer, sub.Escrow)
+	return err
+}
+
+func (k Keeper) CancelSubscription(ctx sdk.Context, id uint64, consumer sdk.AccAddress) (Subscription, sdk.Error) {
+	sub, found := k.GetSubscription(ctx, id)
+	if !found {
+		return sub, ErrSubscriptionNotExists(k.Codespace(), id)
+	}
+	if !sub.Consumer.Equals(consumer) {
+		return sub, ErrNotMatchingConsumer(k.Codespace(), consumer)
+	}
+	return sub, k.CloseSubscription(ctx, sub)
+}
+
+// Returns the current state of each request in a multicast request
+func (k Keeper) GetMulticastRequestStates(ctx sdk.Context, mreq MulticastRequest) []MulticastRequestState {
+	states := make([]MulticastRequestState, len(mreq.RequestIDs))
+	for i, requestID := range mreq.RequestIDs {
+		states[i] = MulticastRequestState{
+			RequestID: requestID,
+			Provider:  mreq.Providers[i],
+			Status:    RequestStatusClosed,
+		}
+
+		eHeight, rHeight, counter, err := ConvertRequestID(requestID)
+		if err != nil {
+			continue
+		}
+		if _, found := k.GetActiveRequest(ctx, eHeight, rHeight, counter); found {
+			states[i].Status = RequestStatusActive
+		} else if _, found := k.GetResponse(ctx, mreq.ReqChainID, eHeight, rHeight, counter); found {
+			states[i].Status = RequestStatusResponded
+		}
+	}
+	return states
+}
+
 // Returns an iterator for all the request in the Active Queue of specified service binding
 func (k Keeper) ActiveBindRequestsIterator(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -400,6 +937,8 @@ func (k Keeper) AddResponse(ctx sdk.Context, resp SvcResponse) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(resp)
 	store.Set(GetResponseKey(resp.ReqChainID, resp.ExpirationHeight, resp.RequestHeight, resp.RequestIntraTxCounter), bz)
+	k.addConsumerHistory(ctx, GetConsumerResponsesSubspaceKey(resp.Consumer),
+		GetConsumerResponseKey(resp.Consumer, resp.RequestHeight, resp.RequestIntraTxCounter), bz)
 }
 
 func (k Keeper) GetResponse(ctx sdk.Context, reqChainID string, eHeight, rHeight int64, counter int16) (resp SvcResponse, found bool) {
@@ -412,6 +951,24 @@ func (k Keeper) GetResponse(ctx sdk.Context, reqChainID string, eHeight, rHeight
 	return resp, true
 }
 
+// Returns the responses of a particular consumer by page, in the order of request height
+func (k Keeper) GetConsumerResponses(ctx sdk.Context, consumer sdk.AccAddress, page uint64, size uint16) (responses []SvcResponse) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetConsumerResponsesSubspaceKey(consumer))
+	defer iterator.Close()
+
+	skip := sdk.GetSkipCount(page, size)
+	responses = make([]SvcResponse, 0, size)
+	for i := 0; iterator.Valid() && i < int(skip)+int(size); iterator.Next() {
+		if i >= int(skip) {
+			var response SvcResponse
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &response)
+			responses = append(responses, response)
+		}
+		i++
+	}
+	return responses
+}
+
 //__________________________________________________________________________
 
 func (k Keeper) SetReturnFee(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
@@ -23,6 +23,27 @@ var (
 
 	serviceFeeTaxKey        = []byte{0x12}
 	serviceSlashFractionKey = []byte{0x13}
+
+	consumerRequestKey  = []byte{0x14} // key for the request history of a consumer
+	consumerResponseKey = []byte{0x15} // key for the response history of a consumer
+	multicastRequestKey = []byte{0x16} // key for multicast request
+	bindingStatsKey     = []byte{0x17} // key for the service history of a binding
+
+	serviceDefinitionVersionKey = []byte{0x18} // key for the service definitions of version 2 and later
+	methodPropertyVersionKey    = []byte{0x19} // key for the methods of version 2 and later
+	latestDefinitionVersionKey  = []byte{0x20} // key for the latest version of a service definition
+	deprecatedDefinitionKey     = []byte{0x21} // key for the deprecated versions of a service definition
+
+	bindingPricingKey = []byte{0x22} // key for the pricing rules of a binding
+	consumerVolumeKey = []byte{0x23} // key for the requests made by a consumer to a binding per block
+
+	subscriptionKey         = []byte{0x24} // key for subscription
+	subscriptionQueueKey    = []byte{0x25} // key for the subscriptions by the height of the next request
+	consumerSubscriptionKey = []byte{0x26} // key for the subscriptions of a consumer
+	nextSubscriptionIDKey   = []byte{0x27} // key for the id of the next subscription
+
+	consumerHistoryCountKey  = []byte{0x28} // key for the number of entries in the history of a consumer
+	multicastRequestIndexKey = []byte{0x29} // key for the multicast request of a request
 )
 
 func GetServiceDefinitionKey(chainId, name string) []byte {
@@ -34,6 +55,39 @@ func GetMethodPropertyKey(chainId, serviceName string, id int16) []byte {
 	return append(methodPropertyKey, getStringsKey([]string{chainId, serviceName, string(id)})...)
 }
 
+// the definitions before versioning are stored as version 1 under GetServiceDefinitionKey,
+// key of later versions is of format prefix || chainId || 0x00 || name || 0x00 || version(8)
+func GetServiceDefinitionVersionKey(chainId, name string, version uint64) []byte {
+	return append(serviceDefinitionVersionKey, getNameVersionBytes(chainId, name, version)...)
+}
+
+// key is of format prefix || chainId || 0x00 || name || 0x00 || version(8) || id(2)
+func GetVersionMethodPropertyKey(chainId, serviceName string, version uint64, id int16) []byte {
+	bz := make([]byte, 2)
+	binary.BigEndian.PutUint16(bz, uint16(id))
+	return append(GetVersionMethodsSubspaceKey(chainId, serviceName, version), bz...)
+}
+
+// Key for getting all methods of a version of a service from the store
+func GetVersionMethodsSubspaceKey(chainId, serviceName string, version uint64) []byte {
+	return append(methodPropertyVersionKey, getNameVersionBytes(chainId, serviceName, version)...)
+}
+
+func GetLatestDefinitionVersionKey(chainId, name string) []byte {
+	return append(latestDefinitionVersionKey, getStringsKey([]string{chainId, name})...)
+}
+
+func GetDeprecatedDefinitionKey(chainId, name string, version uint64) []byte {
+	return append(deprecatedDefinitionKey, getNameVersionBytes(chainId, name, version)...)
+}
+
+// bytes of format chainId || 0x00 || name || 0x00 || version(8)
+func getNameVersionBytes(chainId, name string, version uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return append(append(getStringsKey([]string{chainId, name}), emptyByte...), bz...)
+}
+
 // Key for getting all methods on a service from the store
 func GetMethodsSubspaceKey(chainId, serviceName string) []byte {
 	return append(append(methodPropertyKey, getStringsKey([]string{chainId, serviceName})...), emptyByte...)
@@ -43,6 +97,29 @@ func GetServiceBindingKey(defChainId, name, bindChainId string, provider sdk.Acc
 	return append(bindingPropertyKey, getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...)
 }
 
+func GetBindingStatsKey(defChainId, name, bindChainId string, provider sdk.AccAddress) []byte {
+	return append(bindingStatsKey, getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...)
+}
+
+func GetBindingPricingKey(defChainId, name, bindChainId string, provider sdk.AccAddress) []byte {
+	return append(bindingPricingKey, getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...)
+}
+
+// key is of format prefix || defChainId || 0x00 || name || 0x00 || bindChainId || 0x00 || provider || 0x00 || consumer || requestHeight(8)
+func GetConsumerVolumeKey(defChainId, name, bindChainId string, provider, consumer sdk.AccAddress, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetConsumerVolumeSubspaceKey(defChainId, name, bindChainId, provider, consumer), bz...)
+}
+
+// get the prefix for the requests made by a consumer to a binding
+func GetConsumerVolumeSubspaceKey(defChainId, name, bindChainId string, provider, consumer sdk.AccAddress) []byte {
+	return append(append(append(consumerVolumeKey,
+		getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...),
+		emptyByte...),
+		consumer.Bytes()...)
+}
+
 // Key for getting all methods on a service from the store
 func GetBindingsSubspaceKey(chainId, serviceName string) []byte {
 	return append(append(bindingPropertyKey, getStringsKey([]string{chainId, serviceName})...), emptyByte...)
@@ -94,6 +171,73 @@ func GetRequestsByExpirationPrefix(height int64) []byte {
 	return key
 }
 
+// get the key of a request in the history of a consumer
+func GetConsumerRequestKey(consumer sdk.AccAddress, rHeight int64, counter int16) []byte {
+	return append(GetConsumerRequestsSubspaceKey(consumer), getHeightCounterBytes(rHeight, counter)...)
+}
+
+// get the prefix for all requests of a consumer
+func GetConsumerRequestsSubspaceKey(consumer sdk.AccAddress) []byte {
+	return append(consumerRequestKey, consumer.Bytes()...)
+}
+
+// get the key of a response in the history of a consumer
+func GetConsumerResponseKey(consumer sdk.AccAddress, rHeight int64, counter int16) []byte {
+	return append(GetConsumerResponsesSubspaceKey(consumer), getHeightCounterBytes(rHeight, counter)...)
+}
+
+// get the prefix for all responses of a consumer
+func GetConsumerResponsesSubspaceKey(consumer sdk.AccAddress) []byte {
+	return append(consumerResponseKey, consumer.Bytes()...)
+}
+
+// get the key of the number of entries in a history of a consumer, given the prefix of the history
+func GetConsumerHistoryCountKey(historyPrefix []byte) []byte {
+	return append(consumerHistoryCountKey, historyPrefix...)
+}
+
+// key is of format prefix || requestHeight(8) || counterBytes(2)
+func GetMulticastRequestKey(rHeight int64, counter int16) []byte {
+	return append(multicastRequestKey, getHeightCounterBytes(rHeight, counter)...)
+}
+
+// key is of format prefix || requestHeight(8) || counterBytes(2)
+func GetMulticastRequestIndexKey(rHeight int64, counter int16) []byte {
+	return append(multicastRequestIndexKey, getHeightCounterBytes(rHeight, counter)...)
+}
+
+// bytes of format requestHeight(8) || counterBytes(2)
+func getHeightCounterBytes(rHeight int64, counter int16) []byte {
+	bz := make([]byte, 8+2)
+	binary.BigEndian.PutUint64(bz[0:8], uint64(rHeight))
+	binary.BigEndian.PutUint16(bz[8:10], uint16(counter))
+	return bz
+}
+
+func GetSubscriptionKey(id uint64) []byte {
+	return append(subscriptionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// key is of format prefix || nextHeight(8) || id(8)
+func GetSubscriptionQueueKey(nextHeight int64, id uint64) []byte {
+	return append(GetSubscriptionQueuePrefix(nextHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// get the prefix for the subscriptions whose next request is at the given height
+func GetSubscriptionQueuePrefix(nextHeight int64) []byte {
+	return append(subscriptionQueueKey, sdk.Uint64ToBigEndian(uint64(nextHeight))...)
+}
+
+// key is of format prefix || consumer || id(8)
+func GetConsumerSubscriptionKey(consumer sdk.AccAddress, id uint64) []byte {
+	return append(GetConsumerSubscriptionsSubspaceKey(consumer), sdk.Uint64ToBigEndian(id)...)
+}
+
+// get the prefix for all subscriptions of a consumer
+func GetConsumerSubscriptionsSubspaceKey(consumer sdk.AccAddress) []byte {
+	return append(consumerSubscriptionKey, consumer.Bytes()...)
+}
+
 func GetReturnedFeeKey(address sdk.AccAddress) []byte {
 	return append(returnedFeeKey, address.Bytes()...)
 }
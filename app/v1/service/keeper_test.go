@@ -1,8 +1,10 @@
 package service
 
 import (
+	"math"
 	"testing"
 
+	"github.com/irisnet/irishub/tools/protoidl"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -128,6 +130,297 @@ func TestKeeper_service_Call(t *testing.T) {
 	}
 }
 
+func TestKeeper_service_CancelRequest(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	keeper.AddServiceBinding(ctx, svcBinding)
+
+	svcRequest := NewSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{price}, false)
+	svcRequest, err := keeper.AddRequest(ctx, svcRequest)
+	require.NoError(t, err)
+	svcRequest2 := NewSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("5678"), sdk.Coins{price}, false)
+	svcRequest2, err = keeper.AddRequest(ctx, svcRequest2)
+	require.NoError(t, err)
+
+	requests := keeper.GetConsumerRequests(ctx, addrs[2], 1, 10)
+	require.Equal(t, 2, len(requests))
+	require.Equal(t, svcRequest.RequestID(), requests[0].RequestID())
+	requests = keeper.GetConsumerRequests(ctx, addrs[2], 2, 1)
+	require.Equal(t, 1, len(requests))
+	require.Equal(t, svcRequest2.RequestID(), requests[0].RequestID())
+
+	// only the consumer can cancel the request
+	_, err = keeper.CancelRequest(ctx, svcRequest.ExpirationHeight, svcRequest.RequestHeight, svcRequest.RequestIntraTxCounter, addrs[1])
+	require.Error(t, err)
+
+	_, err = keeper.CancelRequest(ctx, svcRequest.ExpirationHeight, svcRequest.RequestHeight, svcRequest.RequestIntraTxCounter, addrs[2])
+	require.NoError(t, err)
+	_, found := keeper.GetActiveRequest(ctx, svcRequest.ExpirationHeight, svcRequest.RequestHeight, svcRequest.RequestIntraTxCounter)
+	require.False(t, found)
+	returnedFee, found := keeper.GetReturnFee(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{price}, returnedFee.Coins)
+
+	// a cancelled request can not be cancelled again
+	_, err = keeper.CancelRequest(ctx, svcRequest.ExpirationHeight, svcRequest.RequestHeight, svcRequest.RequestIntraTxCounter, addrs[2])
+	require.Error(t, err)
+
+	// a responded request can not be cancelled
+	svcResponse := NewSvcResponse("testnet", svcRequest2.ExpirationHeight, svcRequest2.RequestHeight, svcRequest2.RequestIntraTxCounter,
+		addrs[1], addrs[2], []byte("output"), nil)
+	keeper.AddResponse(ctx, svcResponse)
+	keeper.DeleteActiveRequest(ctx, svcRequest2)
+	keeper.DeleteRequestExpiration(ctx, svcRequest2)
+	_, err = keeper.CancelRequest(ctx, svcRequest2.ExpirationHeight, svcRequest2.RequestHeight, svcRequest2.RequestIntraTxCounter, addrs[2])
+	require.Error(t, err)
+
+	responses := keeper.GetConsumerResponses(ctx, addrs[2], 1, 10)
+	require.Equal(t, 1, len(responses))
+	require.Equal(t, []byte("output"), responses[0].Output)
+}
+
+func TestKeeper_service_ConsumerHistory(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// the oldest responses are deleted once the history is full
+	for counter := int16(0); counter < MaxConsumerHistory+2; counter++ {
+		keeper.AddResponse(ctx, NewSvcResponse("testnet", 100, 1, counter, addrs[0], addrs[1], []byte("output"), nil))
+	}
+	responses := keeper.GetConsumerResponses(ctx, addrs[1], 1, MaxConsumerHistory+2)
+	require.Equal(t, MaxConsumerHistory, len(responses))
+	require.Equal(t, int16(2), responses[0].RequestIntraTxCounter)
+	require.Equal(t, int16(MaxConsumerHistory+1), responses[MaxConsumerHistory-1].RequestIntraTxCounter)
+
+	// the responses are still available by request id
+	_, found := keeper.GetResponse(ctx, "testnet", 100, 1, 0)
+	require.True(t, found)
+}
+
+func TestKeeper_service_Multicast(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 4)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	for _, addr := range addrs[1:] {
+		keeper.ck.AddCoins(ctx, addr, sdk.Coins{coin})
+	}
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	require.NoError(t, keeper.AddMethods(ctx, serviceDef))
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	for _, provider := range addrs[1:3] {
+		svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+			provider, Global, sdk.Coins{deposit}, []sdk.Coin{price},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+	}
+
+	handler := NewHandler(keeper)
+	consumer := addrs[3]
+
+	// not enough available bindings
+	msg := NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		consumer, nil, 3, 1, []byte("\x0a\x041234"), sdk.Coins{price}, false)
+	require.False(t, handler(ctx, msg).IsOK())
+
+	balance := keeper.ck.GetCoins(ctx, consumer)
+	msg.ProviderCount = 2
+	require.True(t, handler(ctx, msg).IsOK())
+
+	mreq, found := keeper.GetMulticastRequest(ctx, ctx.BlockHeight(), 0)
+	require.True(t, found)
+	require.Equal(t, 2, len(mreq.RequestIDs))
+
+	// the service fee is escrowed for each provider
+	require.Equal(t, balance.Sub(sdk.Coins{price}.Add(sdk.Coins{price})), keeper.ck.GetCoins(ctx, consumer))
+
+	states := keeper.GetMulticastRequestStates(ctx, mreq)
+	require.Equal(t, MulticastStatusPending, AggregateMulticastStatus(states))
+
+	// respond one of the requests
+	res := handler(ctx, NewMsgSvcResponse("testnet", mreq.RequestIDs[0], mreq.Providers[0], []byte("\x0a\x06output"), nil))
+	require.True(t, res.IsOK())
+	states = keeper.GetMulticastRequestStates(ctx, mreq)
+	require.Equal(t, RequestStatusResponded, states[0].Status)
+	require.Equal(t, RequestStatusActive, states[1].Status)
+	_, found = keeper.GetMulticastRequest(ctx, mreq.RequestHeight, mreq.RequestIntraTxCounter)
+	require.True(t, found)
+
+	// cancel the other one
+	res = handler(ctx, NewMsgSvcCancelRequest(mreq.RequestIDs[1], consumer))
+	require.True(t, res.IsOK())
+	states = keeper.GetMulticastRequestStates(ctx, mreq)
+	require.Equal(t, MulticastStatusPartial, AggregateMulticastStatus(states))
+
+	// the multicast request is deleted once all of its requests are closed
+	_, found = keeper.GetMulticastRequest(ctx, mreq.RequestHeight, mreq.RequestIntraTxCounter)
+	require.False(t, found)
+	store := ctx.KVStore(keeper.storeKey)
+	for _, requestID := range mreq.RequestIDs {
+		_, rHeight, counter, _ := ConvertRequestID(requestID)
+		require.Nil(t, store.Get(GetMulticastRequestIndexKey(rHeight, counter)))
+	}
+}
+
+func TestKeeper_service_BindingStats(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	require.NoError(t, keeper.AddMethods(ctx, serviceDef))
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	handler := NewHandler(keeper)
+	msg := NewMsgSvcRequest("testnet", "myService", "testnet", "testnet", addrs[2], addrs[1], 1, []byte("\x0a\x041234"), sdk.Coins{price}, false)
+	require.True(t, handler(ctx, msg).IsOK())
+	request1, _ := keeper.GetActiveRequest(ctx, ctx.BlockHeight()+keeper.GetParamSet(ctx).MaxRequestTimeout, ctx.BlockHeight(), 0)
+	require.True(t, handler(ctx, msg).IsOK())
+	request2, _ := keeper.GetActiveRequest(ctx, ctx.BlockHeight()+keeper.GetParamSet(ctx).MaxRequestTimeout, ctx.BlockHeight(), 1)
+
+	// respond the first request 2 blocks later
+	ctx = ctx.WithBlockHeight(request1.RequestHeight + 2)
+	res := handler(ctx, NewMsgSvcResponse("testnet", request1.RequestID(), addrs[1], []byte("\x0a\x06output"), nil))
+	require.True(t, res.IsOK())
+
+	// the second request times out
+	ctx = ctx.WithBlockHeight(request2.ExpirationHeight)
+	EndBlocker(ctx, keeper)
+
+	stats := keeper.GetBindingStats(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.Equal(t, uint64(1), stats.ServedRequests)
+	require.Equal(t, uint64(1), stats.TimeoutRequests)
+	require.Equal(t, uint64(2), stats.TotalResponseHeights)
+	require.False(t, stats.SlashedCoins.IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), stats.SuccessRate())
+	require.Equal(t, sdk.NewDec(2), stats.AvgResponseHeight())
+
+	querier := NewQuerier(keeper)
+	bz, _ := keeper.cdc.MarshalJSON(QueryBindingsParams{DefChainID: "testnet", ServiceName: "myService", MinSuccessRate: "0.6"})
+	res2, err := querier(ctx, []string{QueryBindings}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var bindings []SvcBinding
+	keeper.cdc.MustUnmarshalJSON(res2, &bindings)
+	require.Equal(t, 0, len(bindings))
+
+	bz, _ = keeper.cdc.MarshalJSON(QueryBindingsParams{DefChainID: "testnet", ServiceName: "myService", MinSuccessRate: "0.5"})
+	res2, err = querier(ctx, []string{QueryBindings}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(res2, &bindings)
+	require.Equal(t, 1, len(bindings))
+	require.Equal(t, addrs[1], bindings[0].Provider)
+
+	bz, _ = keeper.cdc.MarshalJSON(QueryBindingParams{DefChainID: "testnet", ServiceName: "myService", BindChainId: "testnet", Provider: addrs[1]})
+	res2, err = querier(ctx, []string{QueryBindingStats}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var output BindingStatsOutput
+	keeper.cdc.MustUnmarshalJSON(res2, &output)
+	require.Equal(t, stats, output.Stats)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), output.SuccessRate)
+	require.Equal(t, sdk.NewDec(2), output.AvgResponseHeight)
+
+	// the stats are deleted once the deposit of the binding is refunded
+	if binding, _ := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1]); binding.Available {
+		require.NoError(t, keeper.Disable(ctx, "testnet", "myService", "testnet", addrs[1]))
+	}
+	params := keeper.GetParamSet(ctx)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.ArbitrationTimeLimit).Add(params.ComplaintRetrospect))
+	require.NoError(t, keeper.RefundDeposit(ctx, "testnet", "myService", "testnet", addrs[1]))
+	require.Equal(t, BindingStats{}, keeper.GetBindingStats(ctx, "testnet", "myService", "testnet", addrs[1]))
+}
+
+func TestKeeper_service_PayloadValidation(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	require.NoError(t, keeper.AddMethods(ctx, serviceDef))
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	handler := NewHandler(keeper)
+
+	// the input is not a HelloRequest
+	msg := NewMsgSvcRequest("testnet", "myService", "testnet", "testnet", addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{price}, false)
+	res := handler(ctx, msg)
+	require.Equal(t, CodeInvalidPayload, res.Code)
+
+	msg.Input = []byte("\x0a\x041234")
+	require.True(t, handler(ctx, msg).IsOK())
+	request, _ := keeper.GetActiveRequest(ctx, ctx.BlockHeight()+keeper.GetParamSet(ctx).MaxRequestTimeout, ctx.BlockHeight(), 0)
+
+	// the output is not a HelloReply
+	res = handler(ctx, NewMsgSvcResponse("testnet", request.RequestID(), addrs[1], []byte{0x10, 0x01}, nil))
+	require.Equal(t, CodeInvalidPayload, res.Code)
+
+	res = handler(ctx, NewMsgSvcResponse("testnet", request.RequestID(), addrs[1], []byte("\x0a\x06output"), nil))
+	require.True(t, res.IsOK())
+
+	// the methods defined before the descriptors were added are not validated
+	method, _ := keeper.GetMethod(ctx, "testnet", "myService", 1)
+	method.RequestDescriptor = protoidl.MessageDescriptor{}
+	method.ResponseDescriptor = protoidl.MessageDescriptor{}
+	ctx.KVStore(keeper.storeKey).Set(GetMethodPropertyKey("testnet", "myService", 1), keeper.cdc.MustMarshalBinaryLengthPrefixed(method))
+
+	msg.Input = []byte("1234")
+	require.True(t, handler(ctx, msg).IsOK())
+	request, _ = keeper.GetActiveRequest(ctx, ctx.BlockHeight()+keeper.GetParamSet(ctx).MaxRequestTimeout, ctx.BlockHeight(), 1)
+	res = handler(ctx, NewMsgSvcResponse("testnet", request.RequestID(), addrs[1], []byte{0x10, 0x01}, nil))
+	require.True(t, res.IsOK())
+}
+
 const idlContent = `
 	syntax = "proto3";
 
@@ -148,3 +441,240 @@ const idlContent = `
 	message HelloReply {
 		string message = 1;
 	}`
+
+func TestKeeper_service_DefinitionVersions(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("3000iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+
+	handler := NewHandler(keeper)
+	require.True(t, handler(ctx, NewMsgSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)).IsOK())
+
+	// only the author can define a new version
+	res := handler(ctx, NewMsgSvcDefVersion("myService", "testnet", "the second version",
+		[]string{"test"}, addrs[1], "unit test author", idlContent))
+	require.Equal(t, CodeNotMatchingAuthor, res.Code)
+
+	res = handler(ctx, NewMsgSvcDefVersion("myService", "testnet", "the second version",
+		[]string{"test"}, addrs[0], "unit test author", idlContent))
+	require.True(t, res.IsOK())
+	require.Equal(t, uint64(2), keeper.GetLatestVersion(ctx, "testnet", "myService"))
+
+	svcDef, found := keeper.GetServiceDefinitionVersion(ctx, "testnet", "myService", 1)
+	require.True(t, found)
+	require.Equal(t, "the service for unit test", svcDef.Description)
+	svcDef, found = keeper.GetServiceDefinitionVersion(ctx, "testnet", "myService", 0)
+	require.True(t, found)
+	require.Equal(t, "the second version", svcDef.Description)
+	_, found = keeper.GetServiceDefinitionVersion(ctx, "testnet", "myService", 3)
+	require.False(t, found)
+
+	methods := keeper.GetVersionMethods(ctx, "testnet", "myService", 2)
+	require.True(t, methods.Valid())
+	methods.Close()
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	level := Level{AvgRspTime: 10000, UsableTime: 9999}
+
+	// bind to the first version
+	res = handler(ctx, NewMsgSvcBind("testnet", "myService", "testnet", addrs[1], Global,
+		sdk.Coins{deposit}, []sdk.Coin{price}, level, 1))
+	require.True(t, res.IsOK())
+	binding, found := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(1), binding.DefVersion)
+
+	// only the author can deprecate a version
+	res = handler(ctx, NewMsgSvcDefDeprecate("testnet", "myService", 1, addrs[1]))
+	require.Equal(t, CodeNotMatchingAuthor, res.Code)
+
+	res = handler(ctx, NewMsgSvcDefDeprecate("testnet", "myService", 1, addrs[0]))
+	require.True(t, res.IsOK())
+	require.True(t, keeper.IsDeprecated(ctx, "testnet", "myService", 1))
+	require.False(t, keeper.IsDeprecated(ctx, "testnet", "myService", 2))
+
+	// the existing binding is kept, new bindings to the deprecated version are rejected
+	_, found = keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, found)
+	res = handler(ctx, NewMsgSvcBind("testnet", "myService", "bindchain", addrs[1], Global,
+		sdk.Coins{deposit}, []sdk.Coin{price}, level, 1))
+	require.Equal(t, CodeSvcDefDeprecated, res.Code)
+
+	// binding without a version pins the latest one
+	res = handler(ctx, NewMsgSvcBind("testnet", "myService", "bindchain", addrs[1], Global,
+		sdk.Coins{deposit}, []sdk.Coin{price}, level, 0))
+	require.True(t, res.IsOK())
+	binding, _ = keeper.GetServiceBinding(ctx, "testnet", "myService", "bindchain", addrs[1])
+	require.Equal(t, uint64(2), binding.DefVersion)
+}
+
+func TestKeeper_service_Pricing(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 4)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[3], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	require.NoError(t, keeper.AddMethods(ctx, serviceDef))
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	discounted, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.5iris")
+	special, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	handler := NewHandler(keeper)
+
+	pricing := Pricing{
+		Window:    10,
+		Tiers:     []VolumeTier{{MinRequests: 2, Discount: sdk.NewDecWithPrec(5, 1)}},
+		Consumers: []ConsumerPrice{{Consumer: addrs[3], Prices: []sdk.Coin{special, special}}},
+	}
+	// the special prices must cover all methods
+	res := handler(ctx, NewMsgSvcSetPricing("testnet", "myService", "testnet", addrs[1], pricing))
+	require.Equal(t, CodeInvalidPriceCount, res.Code)
+
+	pricing.Consumers[0].Prices = []sdk.Coin{special}
+	// a full discount is rejected since the service fee can not be zero
+	pricing.Tiers[0].Discount = sdk.OneDec()
+	require.Equal(t, CodeInvalidPricing, NewMsgSvcSetPricing("testnet", "myService", "testnet", addrs[1], pricing).ValidateBasic().Code())
+	pricing.Tiers[0].Discount = sdk.NewDecWithPrec(5, 1)
+	require.True(t, handler(ctx, NewMsgSvcSetPricing("testnet", "myService", "testnet", addrs[1], pricing)).IsOK())
+	require.Equal(t, pricing.Window, keeper.GetBindingPricing(ctx, "testnet", "myService", "testnet", addrs[1]).Window)
+
+	request := func(ctx sdk.Context, consumer sdk.AccAddress, fee sdk.Coin) sdk.Result {
+		return handler(ctx, NewMsgSvcRequest("testnet", "myService", "testnet", "testnet", consumer, addrs[1], 1,
+			[]byte("\x0a\x041234"), sdk.Coins{fee}, false))
+	}
+
+	// the volume tier is reached after two requests in the window
+	require.Equal(t, []sdk.Coin{price}, keeper.GetEffectivePrices(ctx, svcBinding, addrs[2]))
+	require.True(t, request(ctx, addrs[2], price).IsOK())
+	require.Equal(t, CodeLtServiceFee, request(ctx, addrs[2], discounted).Code)
+	ctx = ctx.WithBlockHeight(2)
+	require.True(t, request(ctx, addrs[2], price).IsOK())
+	require.Equal(t, uint64(2), keeper.GetConsumerVolume(ctx, svcBinding, addrs[2], pricing.Window))
+	require.Equal(t, []sdk.Coin{discounted}, keeper.GetEffectivePrices(ctx, svcBinding, addrs[2]))
+
+	res = request(ctx, addrs[2], price)
+	require.True(t, res.IsOK())
+	require.Contains(t, string(res.Tags[len(res.Tags)-1].Value), discounted.String())
+
+	// the special prices of a consumer take precedence
+	require.True(t, request(ctx, addrs[3], special).IsOK())
+
+	// the requests out of the window are not counted
+	ctx = ctx.WithBlockHeight(11)
+	require.Equal(t, uint64(2), keeper.GetConsumerVolume(ctx, svcBinding, addrs[2], pricing.Window))
+	ctx = ctx.WithBlockHeight(12)
+	require.Equal(t, uint64(0), keeper.GetConsumerVolume(ctx, svcBinding, addrs[2], pricing.Window))
+	require.Equal(t, []sdk.Coin{price}, keeper.GetEffectivePrices(ctx, svcBinding, addrs[2]))
+
+	// empty pricing rules remove the existing ones
+	require.True(t, handler(ctx, NewMsgSvcSetPricing("testnet", "myService", "testnet", addrs[1], Pricing{})).IsOK())
+	require.Equal(t, []sdk.Coin{price}, keeper.GetEffectivePrices(ctx, svcBinding, addrs[3]))
+}
+
+func TestKeeper_service_Subscription(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService", "testnet", "the service for unit test",
+		[]string{"test", "tutorial"}, addrs[0], "unit test author", idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	require.NoError(t, keeper.AddMethods(ctx, serviceDef))
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1iris")
+	escrow, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("2.5iris")
+	left, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.5iris")
+	twoPrices, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("2iris")
+	threePrices, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("3iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	handler := NewHandler(keeper)
+	input := []byte("\x0a\x041234")
+
+	// the service fee must cover the price
+	msg := NewMsgSvcSubscribe("testnet", "myService", "testnet", "testnet", addrs[2], addrs[1], 1, input,
+		sdk.Coins{left}, 2, 3, sdk.Coins{escrow})
+	require.Equal(t, CodeLtServiceFee, handler(ctx, msg).Code)
+	msg.ServiceFee = sdk.Coins{price}
+
+	// the height of the next request must not overflow
+	msg.Interval = math.MaxInt64 + 1
+	require.Equal(t, CodeInvalidSubscription, msg.ValidateBasic().Code())
+	msg.Interval = math.MaxInt64
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, CodeInvalidSubscription, handler(ctx, msg).Code)
+	msg.Interval = 2
+
+	balance := keeper.ck.GetCoins(ctx, addrs[2])
+	require.True(t, handler(ctx, msg).IsOK())
+	require.Equal(t, balance.Sub(sdk.Coins{escrow}), keeper.ck.GetCoins(ctx, addrs[2]))
+
+	subs := keeper.GetConsumerSubscriptions(ctx, addrs[2])
+	require.Equal(t, 1, len(subs))
+	require.Equal(t, uint64(1), subs[0].ID)
+
+	// the first request is sent at the end of the block
+	EndBlocker(ctx, keeper)
+	sub, _ := keeper.GetSubscription(ctx, 1)
+	require.Equal(t, uint64(1), sub.Repetitions)
+	require.Equal(t, int64(3), sub.NextHeight)
+	_, _, counter, _ := ConvertRequestID(sub.LastRequestID)
+	_, found := keeper.GetActiveRequest(ctx, ctx.BlockHeight()+keeper.GetParamSet(ctx).MaxRequestTimeout, ctx.BlockHeight(), counter)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(2)
+	EndBlocker(ctx, keeper)
+	sub, _ = keeper.GetSubscription(ctx, 1)
+	require.Equal(t, uint64(1), sub.Repetitions)
+
+	ctx = ctx.WithBlockHeight(3)
+	EndBlocker(ctx, keeper)
+	sub, _ = keeper.GetSubscription(ctx, 1)
+	require.Equal(t, uint64(2), sub.Repetitions)
+	require.Equal(t, sdk.Coins{left}, sub.Escrow)
+
+	// the escrow runs out, the rest is refunded
+	ctx = ctx.WithBlockHeight(5)
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetSubscription(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, 0, len(keeper.GetConsumerSubscriptions(ctx, addrs[2])))
+	require.Equal(t, balance.Sub(sdk.Coins{twoPrices}), keeper.ck.GetCoins(ctx, addrs[2]))
+
+	// cancel a subscription refunds the escrow left
+	require.True(t, handler(ctx, msg).IsOK())
+	EndBlocker(ctx, keeper)
+	require.Equal(t, CodeNotMatchingConsumer, handler(ctx, NewMsgSvcCancelSubscription(2, addrs[1])).Code)
+	require.True(t, handler(ctx, NewMsgSvcCancelSubscription(2, addrs[2])).IsOK())
+	require.Equal(t, balance.Sub(sdk.Coins{threePrices}), keeper.ck.GetCoins(ctx, addrs[2]))
+	require.Equal(t, CodeInvalidSubscription, handler(ctx, NewMsgSvcCancelSubscription(2, addrs[2])).Code)
+}
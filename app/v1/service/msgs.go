@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 
 	"github.com/irisnet/irishub/tools/protoidl"
@@ -16,7 +17,7 @@ const (
 	description   = "description"
 )
 
-var _, _, _, _, _, _, _, _, _, _, _ sdk.Msg = MsgSvcDef{}, MsgSvcBind{}, MsgSvcBindingUpdate{}, MsgSvcDisable{}, MsgSvcEnable{}, MsgSvcRefundDeposit{}, MsgSvcRequest{}, MsgSvcResponse{}, MsgSvcRefundFees{}, MsgSvcWithdrawFees{}, MsgSvcWithdrawTax{}
+var _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg = MsgSvcDef{}, MsgSvcDefVersion{}, MsgSvcDefDeprecate{}, MsgSvcBind{}, MsgSvcBindingUpdate{}, MsgSvcSetPricing{}, MsgSvcDisable{}, MsgSvcEnable{}, MsgSvcRefundDeposit{}, MsgSvcRequest{}, MsgSvcMulticastRequest{}, MsgSvcResponse{}, MsgSvcCancelRequest{}, MsgSvcSubscribe{}, MsgSvcCancelSubscription{}, MsgSvcRefundFees{}, MsgSvcWithdrawFees{}, MsgSvcWithdrawTax{}
 
 //______________________________________________________________________
 
@@ -83,6 +84,97 @@ func (msg MsgSvcDef) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Author}
 }
 
+//______________________________________________________________________
+
+// MsgSvcDefVersion - struct for define a new version of a service
+type MsgSvcDefVersion struct {
+	SvcDef
+}
+
+func NewMsgSvcDefVersion(name, chainId, description string, tags []string, author sdk.AccAddress, authorDescription, idlContent string) MsgSvcDefVersion {
+	return MsgSvcDefVersion{
+		NewSvcDef(name, chainId, description, tags, author, authorDescription, idlContent),
+	}
+}
+
+func (msg MsgSvcDefVersion) Route() string { return MsgRoute }
+func (msg MsgSvcDefVersion) Type() string  { return "define_service_version" }
+
+func (msg MsgSvcDefVersion) GetSignBytes() []byte {
+	if len(msg.Tags) == 0 {
+		msg.Tags = nil
+	}
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcDefVersion) ValidateBasic() sdk.Error {
+	return MsgSvcDef{msg.SvcDef}.ValidateBasic()
+}
+
+func (msg MsgSvcDefVersion) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Author}
+}
+
+//______________________________________________________________________
+
+// MsgSvcDefDeprecate - struct for deprecate a version of a service
+type MsgSvcDefDeprecate struct {
+	DefChainID string         `json:"def_chain_id"`
+	DefName    string         `json:"def_name"`
+	Version    uint64         `json:"version"`
+	Author     sdk.AccAddress `json:"author"`
+}
+
+func NewMsgSvcDefDeprecate(defChainID, defName string, version uint64, author sdk.AccAddress) MsgSvcDefDeprecate {
+	return MsgSvcDefDeprecate{
+		DefChainID: defChainID,
+		DefName:    defName,
+		Version:    version,
+		Author:     author,
+	}
+}
+
+func (msg MsgSvcDefDeprecate) Route() string { return MsgRoute }
+func (msg MsgSvcDefDeprecate) Type() string  { return "deprecate_service_definition" }
+
+func (msg MsgSvcDefDeprecate) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcDefDeprecate) ValidateBasic() sdk.Error {
+	if len(msg.DefChainID) == 0 {
+		return ErrInvalidDefChainId(DefaultCodespace)
+	}
+	if err := ensureChainIdLength(msg.DefChainID, "def_chain_id"); err != nil {
+		return err
+	}
+	if !validServiceName(msg.DefName) {
+		return ErrInvalidServiceName(DefaultCodespace, msg.DefName)
+	}
+	if err := ensureNameLength(msg.DefName); err != nil {
+		return err
+	}
+	if msg.Version == 0 {
+		return ErrInvalidDefVersion(DefaultCodespace, msg.Version)
+	}
+	if len(msg.Author) == 0 {
+		return ErrInvalidAuthor(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgSvcDefDeprecate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Author}
+}
+
 func validateMethods(methods []protoidl.Method) (bool, sdk.Error) {
 	for _, method := range methods {
 		if len(method.Name) == 0 {
@@ -128,6 +220,9 @@ func methodToMethodProperty(index int, method protoidl.Method) (methodProperty M
 		Description:   method.Attributes[description],
 		OutputPrivacy: opp,
 		OutputCached:  opc,
+
+		RequestDescriptor:  method.Request,
+		ResponseDescriptor: method.Response,
 	}
 	return
 }
@@ -144,9 +239,10 @@ type MsgSvcBind struct {
 	Deposit     sdk.Coins      `json:"deposit"`
 	Prices      []sdk.Coin     `json:"price"`
 	Level       Level          `json:"level"`
+	DefVersion  uint64         `json:"def_version,omitempty"` // the version of the service definition to bind, 0 for the latest version
 }
 
-func NewMsgSvcBind(defChainID, defName, bindChainID string, provider sdk.AccAddress, bindingType BindingType, deposit sdk.Coins, prices []sdk.Coin, level Level) MsgSvcBind {
+func NewMsgSvcBind(defChainID, defName, bindChainID string, provider sdk.AccAddress, bindingType BindingType, deposit sdk.Coins, prices []sdk.Coin, level Level, defVersion uint64) MsgSvcBind {
 	return MsgSvcBind{
 		DefChainID:  defChainID,
 		DefName:     defName,
@@ -156,6 +252,7 @@ func NewMsgSvcBind(defChainID, defName, bindChainID string, provider sdk.AccAddr
 		Deposit:     deposit,
 		Prices:      prices,
 		Level:       level,
+		DefVersion:  defVersion,
 	}
 }
 
@@ -295,6 +392,67 @@ func (msg MsgSvcBindingUpdate) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// MsgSvcSetPricing - struct for set the pricing rules of a service binding
+type MsgSvcSetPricing struct {
+	DefName     string         `json:"def_name"`
+	DefChainID  string         `json:"def_chain_id"`
+	BindChainID string         `json:"bind_chain_id"`
+	Provider    sdk.AccAddress `json:"provider"`
+	Pricing     Pricing        `json:"pricing"`
+}
+
+func NewMsgSvcSetPricing(defChainID, defName, bindChainID string, provider sdk.AccAddress, pricing Pricing) MsgSvcSetPricing {
+	return MsgSvcSetPricing{
+		DefChainID:  defChainID,
+		DefName:     defName,
+		BindChainID: bindChainID,
+		Provider:    provider,
+		Pricing:     pricing,
+	}
+}
+
+func (msg MsgSvcSetPricing) Route() string { return MsgRoute }
+func (msg MsgSvcSetPricing) Type() string  { return "set_service_pricing" }
+
+func (msg MsgSvcSetPricing) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcSetPricing) ValidateBasic() sdk.Error {
+	if len(msg.DefChainID) == 0 {
+		return ErrInvalidDefChainId(DefaultCodespace)
+	}
+	if len(msg.BindChainID) == 0 {
+		return ErrInvalidChainId(DefaultCodespace)
+	}
+	if err := ensureChainIdLength(msg.DefChainID, "def_chain_id"); err != nil {
+		return err
+	}
+	if err := ensureChainIdLength(msg.BindChainID, "bind_chain_id"); err != nil {
+		return err
+	}
+	if !validServiceName(msg.DefName) {
+		return ErrInvalidServiceName(DefaultCodespace, msg.DefName)
+	}
+	if err := ensureNameLength(msg.DefName); err != nil {
+		return err
+	}
+	if len(msg.Provider) == 0 {
+		return sdk.ErrInvalidAddress(msg.Provider.String())
+	}
+	return validatePricing(msg.Pricing)
+}
+
+func (msg MsgSvcSetPricing) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Provider}
+}
+
+//______________________________________________________________________
+
 // MsgSvcDisable - struct for disable a service binding
 type MsgSvcDisable struct {
 	DefName     string         `json:"def_name"`
@@ -563,6 +721,111 @@ func (msg MsgSvcRequest) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// MsgSvcMulticastRequest - struct for call a service method of multiple providers,
+// either the given providers or any ProviderCount available bindings
+type MsgSvcMulticastRequest struct {
+	DefChainID    string           `json:"def_chain_id"`
+	DefName       string           `json:"def_name"`
+	BindChainID   string           `json:"bind_chain_id"`
+	ReqChainID    string           `json:"req_chain_id"`
+	MethodID      int16            `json:"method_id"`
+	Providers     []sdk.AccAddress `json:"providers"`
+	ProviderCount uint16           `json:"provider_count"`
+	Consumer      sdk.AccAddress   `json:"consumer"`
+	Input         []byte           `json:"input"`
+	ServiceFee    sdk.Coins        `json:"service_fee"` // the service fee paid to each provider
+	Profiling     bool             `json:"profiling"`
+}
+
+func NewMsgSvcMulticastRequest(defChainID, defName, bindChainID, reqChainID string, consumer sdk.AccAddress, providers []sdk.AccAddress, providerCount uint16, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) MsgSvcMulticastRequest {
+	return MsgSvcMulticastRequest{
+		DefChainID:    defChainID,
+		DefName:       defName,
+		BindChainID:   bindChainID,
+		ReqChainID:    reqChainID,
+		Consumer:      consumer,
+		Providers:     providers,
+		ProviderCount: providerCount,
+		MethodID:      methodID,
+		Input:         input,
+		ServiceFee:    serviceFee,
+		Profiling:     profiling,
+	}
+}
+
+func (msg MsgSvcMulticastRequest) Route() string { return MsgRoute }
+func (msg MsgSvcMulticastRequest) Type() string  { return "multicast_call_service" }
+
+func (msg MsgSvcMulticastRequest) GetSignBytes() []byte {
+	if len(msg.Input) == 0 {
+		msg.Input = nil
+	}
+	if len(msg.Providers) == 0 {
+		msg.Providers = nil
+	}
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcMulticastRequest) ValidateBasic() sdk.Error {
+	if len(msg.DefChainID) == 0 {
+		return ErrInvalidDefChainId(DefaultCodespace)
+	}
+	if len(msg.BindChainID) == 0 {
+		return ErrInvalidBindChainId(DefaultCodespace)
+	}
+	if len(msg.ReqChainID) == 0 {
+		return ErrInvalidChainId(DefaultCodespace)
+	}
+	if err := ensureChainIdLength(msg.DefChainID, "def_chain_id"); err != nil {
+		return err
+	}
+	if err := ensureChainIdLength(msg.BindChainID, "bind_chain_id"); err != nil {
+		return err
+	}
+	if err := ensureChainIdLength(msg.ReqChainID, "req_chain_id"); err != nil {
+		return err
+	}
+	if !validServiceName(msg.DefName) {
+		return ErrInvalidServiceName(DefaultCodespace, msg.DefName)
+	}
+	if err := ensureNameLength(msg.DefName); err != nil {
+		return err
+	}
+	if (len(msg.Providers) == 0) == (msg.ProviderCount == 0) {
+		return ErrInvalidMulticast(DefaultCodespace, "exactly one of providers and provider count must be specified")
+	}
+	if len(msg.Providers) > MaxMulticastProviders || msg.ProviderCount > MaxMulticastProviders {
+		return ErrInvalidMulticast(DefaultCodespace, fmt.Sprintf("the number of providers must not be greater than %d", MaxMulticastProviders))
+	}
+	for i, provider := range msg.Providers {
+		if len(provider) == 0 {
+			return sdk.ErrInvalidAddress(provider.String())
+		}
+		for _, p := range msg.Providers[:i] {
+			if p.Equals(provider) {
+				return ErrInvalidMulticast(DefaultCodespace, fmt.Sprintf("duplicate provider %s", provider))
+			}
+		}
+	}
+	if len(msg.Consumer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Consumer.String())
+	}
+	if !msg.ServiceFee.IsValidIrisAtto() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid service fee [%s]", msg.ServiceFee))
+	}
+	return nil
+}
+
+func (msg MsgSvcMulticastRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
 // MsgSvcResponse - struct for respond a service call
 type MsgSvcResponse struct {
 	ReqChainID string         `json:"req_chain_id"`
@@ -623,6 +886,153 @@ func (msg MsgSvcResponse) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// MsgSvcCancelRequest - struct for cancel a service call which has not been responded
+type MsgSvcCancelRequest struct {
+	RequestID string         `json:"request_id"`
+	Consumer  sdk.AccAddress `json:"consumer"`
+}
+
+func NewMsgSvcCancelRequest(requestId string, consumer sdk.AccAddress) MsgSvcCancelRequest {
+	return MsgSvcCancelRequest{
+		RequestID: requestId,
+		Consumer:  consumer,
+	}
+}
+
+func (msg MsgSvcCancelRequest) Route() string { return MsgRoute }
+func (msg MsgSvcCancelRequest) Type() string  { return "cancel_service_request" }
+
+func (msg MsgSvcCancelRequest) GetSignBytes() []byte {
+	b := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcCancelRequest) ValidateBasic() sdk.Error {
+	if len(msg.Consumer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Consumer.String())
+	}
+	_, _, _, err := ConvertRequestID(msg.RequestID)
+	if err != nil {
+		return ErrInvalidReqId(DefaultCodespace, msg.RequestID)
+	}
+	return nil
+}
+
+func (msg MsgSvcCancelRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgSvcSubscribe - struct for subscribe a service method, which is requested every interval blocks
+type MsgSvcSubscribe struct {
+	DefChainID     string         `json:"def_chain_id"`
+	DefName        string         `json:"def_name"`
+	BindChainID    string         `json:"bind_chain_id"`
+	ReqChainID     string         `json:"req_chain_id"`
+	MethodID       int16          `json:"method_id"`
+	Provider       sdk.AccAddress `json:"provider"`
+	Consumer       sdk.AccAddress `json:"consumer"`
+	Input          []byte         `json:"input"`
+	ServiceFee     sdk.Coins      `json:"service_fee"`
+	Interval       uint64         `json:"interval"`
+	MaxRepetitions uint64         `json:"max_repetitions"`
+	Escrow         sdk.Coins      `json:"escrow"`
+}
+
+func NewMsgSvcSubscribe(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16,
+	input []byte, serviceFee sdk.Coins, interval, maxRepetitions uint64, escrow sdk.Coins) MsgSvcSubscribe {
+	return MsgSvcSubscribe{
+		DefChainID:     defChainID,
+		DefName:        defName,
+		BindChainID:    bindChainID,
+		ReqChainID:     reqChainID,
+		Consumer:       consumer,
+		Provider:       provider,
+		MethodID:       methodID,
+		Input:          input,
+		ServiceFee:     serviceFee,
+		Interval:       interval,
+		MaxRepetitions: maxRepetitions,
+		Escrow:         escrow,
+	}
+}
+
+func (msg MsgSvcSubscribe) Route() string { return MsgRoute }
+func (msg MsgSvcSubscribe) Type() string  { return "subscribe_service" }
+
+func (msg MsgSvcSubscribe) GetSignBytes() []byte {
+	if len(msg.Input) == 0 {
+		msg.Input = nil
+	}
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcSubscribe) ValidateBasic() sdk.Error {
+	request := NewMsgSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider,
+		msg.MethodID, msg.Input, msg.ServiceFee, false)
+	if err := request.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Interval == 0 {
+		return ErrInvalidSubscription(DefaultCodespace, "interval must be positive")
+	}
+	if msg.Interval > math.MaxInt64 {
+		return ErrInvalidSubscription(DefaultCodespace, fmt.Sprintf("interval must not be greater than %d", int64(math.MaxInt64)))
+	}
+	if msg.MaxRepetitions == 0 {
+		return ErrInvalidSubscription(DefaultCodespace, "max repetitions must be positive")
+	}
+	if msg.Escrow.Empty() || !msg.Escrow.IsValidIrisAtto() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid escrow [%s]", msg.Escrow))
+	}
+	return nil
+}
+
+func (msg MsgSvcSubscribe) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgSvcCancelSubscription - struct for cancel a subscription and refund the escrow left
+type MsgSvcCancelSubscription struct {
+	SubscriptionID uint64         `json:"subscription_id"`
+	Consumer       sdk.AccAddress `json:"consumer"`
+}
+
+func NewMsgSvcCancelSubscription(subscriptionID uint64, consumer sdk.AccAddress) MsgSvcCancelSubscription {
+	return MsgSvcCancelSubscription{
+		SubscriptionID: subscriptionID,
+		Consumer:       consumer,
+	}
+}
+
+func (msg MsgSvcCancelSubscription) Route() string { return MsgRoute }
+func (msg MsgSvcCancelSubscription) Type() string  { return "cancel_service_subscription" }
+
+func (msg MsgSvcCancelSubscription) GetSignBytes() []byte {
+	b := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSvcCancelSubscription) ValidateBasic() sdk.Error {
+	if len(msg.Consumer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Consumer.String())
+	}
+	return nil
+}
+
+func (msg MsgSvcCancelSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
 // MsgSvcRefundFees - struct for refund fees
 type MsgSvcRefundFees struct {
 	Consumer sdk.AccAddress `json:"consumer"`
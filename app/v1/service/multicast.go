@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// the maximum number of providers of a multicast request
+const MaxMulticastProviders = 10
+
+// status of a request in a multicast request
+const (
+	RequestStatusActive    = "Active"    // waiting for the response
+	RequestStatusResponded = "Responded" // responded by the provider
+	RequestStatusClosed    = "Closed"    // timed out or cancelled without response
+)
+
+// aggregated status of a multicast request
+const (
+	MulticastStatusPending   = "Pending"   // some requests are still active
+	MulticastStatusCompleted = "Completed" // all requests have been responded
+	MulticastStatusPartial   = "Partial"   // closed with part of the requests responded
+	MulticastStatusFailed    = "Failed"    // closed without any response
+)
+
+// MulticastRequest groups the requests sent to multiple providers for the same service method
+type MulticastRequest struct {
+	DefChainID            string           `json:"def_chain_id"`
+	DefName               string           `json:"def_name"`
+	BindChainID           string           `json:"bind_chain_id"`
+	ReqChainID            string           `json:"req_chain_id"`
+	MethodID              int16            `json:"method_id"`
+	Consumer              sdk.AccAddress   `json:"consumer"`
+	RequestHeight         int64            `json:"request_height"`           // block height of the multicast request
+	RequestIntraTxCounter int16            `json:"request_intra_tx_counter"` // block-local tx index of the first request
+	Providers             []sdk.AccAddress `json:"providers"`                // the providers the requests are sent to
+	RequestIDs            []string         `json:"request_ids"`              // the ids of the requests sent to each provider
+}
+
+func NewMulticastRequest(defChainID, defName, bindChainID, reqChainID string, consumer sdk.AccAddress, methodID int16) MulticastRequest {
+	return MulticastRequest{
+		DefChainID:  defChainID,
+		DefName:     defName,
+		BindChainID: bindChainID,
+		ReqChainID:  reqChainID,
+		MethodID:    methodID,
+		Consumer:    consumer,
+	}
+}
+
+// MulticastRequestID is of format requestHeight-intraTxCounter
+func (mreq MulticastRequest) MulticastRequestID() string {
+	return fmt.Sprintf("%d-%d", mreq.RequestHeight, mreq.RequestIntraTxCounter)
+}
+
+func ConvertMulticastRequestID(multicastRequestId string) (rHeight int64, counter int16, err error) {
+	ss := strings.Split(multicastRequestId, "-")
+	if len(ss) != 2 {
+		return rHeight, counter, errors.New("invalid multicast request id")
+	}
+	rHeight, err = strconv.ParseInt(ss[0], 10, 64)
+	if err != nil {
+		return rHeight, counter, err
+	}
+	counterInt, err := strconv.ParseInt(ss[1], 10, 16)
+	if err != nil {
+		return rHeight, counter, err
+	}
+	return rHeight, int16(counterInt), nil
+}
+
+// state of a request in a multicast request
+type MulticastRequestState struct {
+	RequestID string         `json:"request_id"`
+	Provider  sdk.AccAddress `json:"provider"`
+	Status    string         `json:"status"`
+}
+
+// returns the aggregated status of the given request states
+func AggregateMulticastStatus(states []MulticastRequestState) string {
+	responded := 0
+	for _, state := range states {
+		switch state.Status {
+		case RequestStatusActive:
+			return MulticastStatusPending
+		case RequestStatusResponded:
+			responded++
+		}
+	}
+
+	switch responded {
+	case len(states):
+		return MulticastStatusCompleted
+	case 0:
+		return MulticastStatusFailed
+	default:
+		return MulticastStatusPartial
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Pricing defines the optional pricing rules of a binding on top of its prices
+type Pricing struct {
+	Window    int64           `json:"window"`    // rolling window of the volume tiers in blocks
+	Tiers     []VolumeTier    `json:"tiers"`     // volume tiers sorted by min requests in ascending order
+	Consumers []ConsumerPrice `json:"consumers"` // consumers with special prices
+}
+
+// VolumeTier discounts the prices for a consumer who has made at least MinRequests requests in the window
+type VolumeTier struct {
+	MinRequests uint64  `json:"min_requests"`
+	Discount    sdk.Dec `json:"discount"` // fraction of the prices discounted, in (0, 1)
+}
+
+// ConsumerPrice defines the special prices of all methods for a consumer
+type ConsumerPrice struct {
+	Consumer sdk.AccAddress `json:"consumer"`
+	Prices   []sdk.Coin     `json:"prices"`
+}
+
+// Empty returns true if no pricing rule is defined
+func (p Pricing) Empty() bool {
+	return len(p.Tiers) == 0 && len(p.Consumers) == 0
+}
+
+func (p Pricing) consumerPrices(consumer sdk.AccAddress) ([]sdk.Coin, bool) {
+	for _, c := range p.Consumers {
+		if c.Consumer.Equals(consumer) {
+			return c.Prices, true
+		}
+	}
+	return nil, false
+}
+
+// tier returns the highest volume tier reached by the given number of requests
+func (p Pricing) tier(volume uint64) (tier VolumeTier, found bool) {
+	for _, t := range p.Tiers {
+		if volume < t.MinRequests {
+			break
+		}
+		tier, found = t, true
+	}
+	return tier, found
+}
+
+// EffectivePrices returns the prices of all methods for a consumer who has made volume requests in the window.
+// The special prices of the consumer take precedence over the volume tiers
+func (p Pricing) EffectivePrices(prices []sdk.Coin, consumer sdk.AccAddress, volume uint64) []sdk.Coin {
+	if special, found := p.consumerPrices(consumer); found {
+		return special
+	}
+	tier, found := p.tier(volume)
+	if !found {
+		return prices
+	}
+	rate := sdk.OneDec().Sub(tier.Discount)
+	effective := make([]sdk.Coin, len(prices))
+	for i, price := range prices {
+		effective[i] = sdk.NewCoin(price.Denom, sdk.NewDecFromInt(price.Amount).Mul(rate).TruncateInt())
+	}
+	return effective
+}
+
+func validatePricing(pricing Pricing) sdk.Error {
+	if len(pricing.Tiers) > 0 && pricing.Window <= 0 {
+		return ErrInvalidPricing(DefaultCodespace, "window of the volume tiers must be positive")
+	}
+	for i, tier := range pricing.Tiers {
+		if tier.MinRequests == 0 {
+			return ErrInvalidPricing(DefaultCodespace, "min requests of a volume tier must be positive")
+		}
+		if i > 0 && tier.MinRequests <= pricing.Tiers[i-1].MinRequests {
+			return ErrInvalidPricing(DefaultCodespace, "volume tiers must be sorted by min requests in ascending order")
+		}
+		if tier.Discount.Int == nil || !tier.Discount.IsPositive() || tier.Discount.GTE(sdk.OneDec()) {
+			return ErrInvalidPricing(DefaultCodespace, fmt.Sprintf("invalid discount of volume tier %d", tier.MinRequests))
+		}
+	}
+	for i, c := range pricing.Consumers {
+		if len(c.Consumer) == 0 {
+			return sdk.ErrInvalidAddress(c.Consumer.String())
+		}
+		for _, other := range pricing.Consumers[:i] {
+			if other.Consumer.Equals(c.Consumer) {
+				return ErrInvalidPricing(DefaultCodespace, fmt.Sprintf("duplicate consumer %s", c.Consumer))
+			}
+		}
+		for _, price := range c.Prices {
+			if !price.IsValidIrisAtto() {
+				return sdk.ErrInvalidCoins(fmt.Sprintf("invalid price [%s]", price))
+			}
+		}
+	}
+	return nil
+}
@@ -11,8 +11,18 @@ const (
 	QueryBinding    = "binding"
 	QueryBindings   = "bindings"
 	QueryRequests   = "requests"
+	QueryRequest    = "request"
 	QueryResponse   = "response"
 	QueryFees       = "fees"
+
+	QueryConsumerRequests   = "consumer_requests"
+	QueryConsumerResponses  = "consumer_responses"
+	QueryMulticastRequest   = "multicast_request"
+	QueryDefinitionVersions = "definition_versions"
+	QueryEffectivePrices    = "effective_prices"
+	QuerySubscription       = "subscription"
+	QuerySubscriptions      = "subscriptions"
+	QueryBindingStats       = "binding_stats"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -26,10 +36,28 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryBindings(ctx, req, k)
 		case QueryRequests:
 			return queryRequests(ctx, req, k)
+		case QueryRequest:
+			return queryRequest(ctx, req, k)
 		case QueryResponse:
 			return queryResponse(ctx, req, k)
 		case QueryFees:
 			return queryFees(ctx, req, k)
+		case QueryConsumerRequests:
+			return queryConsumerRequests(ctx, req, k)
+		case QueryConsumerResponses:
+			return queryConsumerResponses(ctx, req, k)
+		case QueryMulticastRequest:
+			return queryMulticastRequest(ctx, req, k)
+		case QueryDefinitionVersions:
+			return queryDefinitionVersions(ctx, req, k)
+		case QueryEffectivePrices:
+			return queryEffectivePrices(ctx, req, k)
+		case QuerySubscription:
+			return querySubscription(ctx, req, k)
+		case QuerySubscriptions:
+			return querySubscriptions(ctx, req, k)
+		case QueryBindingStats:
+			return queryBindingStats(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown service query endpoint")
 		}
@@ -39,11 +67,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 type QueryServiceParams struct {
 	DefChainID  string
 	ServiceName string
+	Version     uint64 // 0 for the latest version
 }
 
 type DefinitionOutput struct {
 	Definition SvcDef           `json:"definition"`
 	Methods    []MethodProperty `json:"methods"`
+	Version    uint64           `json:"version"`
+	Deprecated bool             `json:"deprecated"`
 }
 
 func queryDefinition(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
@@ -52,12 +83,57 @@ func queryDefinition(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	if err != nil {
 		return nil, sdk.ParseParamsErr(err)
 	}
-	svcDef, found := k.GetServiceDefinition(ctx, params.DefChainID, params.ServiceName)
+
+	version := params.Version
+	if version == 0 {
+		version = k.GetLatestVersion(ctx, params.DefChainID, params.ServiceName)
+	}
+	definitionOutput, found := newDefinitionOutput(ctx, k, params.DefChainID, params.ServiceName, version)
 	if !found {
-		return nil, ErrSvcDefNotExists(DefaultCodespace, params.DefChainID, params.ServiceName)
+		if params.Version == 0 {
+			return nil, ErrSvcDefNotExists(DefaultCodespace, params.DefChainID, params.ServiceName)
+		}
+		return nil, ErrSvcDefVersionNotExists(DefaultCodespace, params.DefChainID, params.ServiceName, params.Version)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, definitionOutput)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryDefinitionVersions(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryServiceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	var definitionOutputs []DefinitionOutput
+	latest := k.GetLatestVersion(ctx, params.DefChainID, params.ServiceName)
+	for version := uint64(1); version <= latest; version++ {
+		definitionOutput, found := newDefinitionOutput(ctx, k, params.DefChainID, params.ServiceName, version)
+		if !found {
+			return nil, ErrSvcDefNotExists(DefaultCodespace, params.DefChainID, params.ServiceName)
+		}
+		definitionOutputs = append(definitionOutputs, definitionOutput)
 	}
 
-	iterator := k.GetMethods(ctx, params.DefChainID, params.ServiceName)
+	bz, err := codec.MarshalJSONIndent(k.cdc, definitionOutputs)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func newDefinitionOutput(ctx sdk.Context, k Keeper, defChainID, name string, version uint64) (DefinitionOutput, bool) {
+	svcDef, found := k.GetServiceDefinitionVersion(ctx, defChainID, name, version)
+	if !found {
+		return DefinitionOutput{}, false
+	}
+
+	iterator := k.GetVersionMethods(ctx, defChainID, name, version)
 	defer iterator.Close()
 	var methods []MethodProperty
 	for ; iterator.Valid(); iterator.Next() {
@@ -66,20 +142,70 @@ func queryDefinition(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		methods = append(methods, method)
 	}
 
-	definitionOutput := DefinitionOutput{Definition: svcDef, Methods: methods}
+	return DefinitionOutput{
+		Definition: svcDef,
+		Methods:    methods,
+		Version:    version,
+		Deprecated: k.IsDeprecated(ctx, defChainID, name, version),
+	}, true
+}
 
-	bz, err := codec.MarshalJSONIndent(k.cdc, definitionOutput)
-	if err != nil {
-		return nil, sdk.MarshalResultErr(err)
+type QueryBindingParams struct {
+	DefChainID  string
+	ServiceName string
+	BindChainId string
+	Provider    sdk.AccAddress
+}
+
+// BindingStatsOutput is the service history of a binding
+type BindingStatsOutput struct {
+	Stats             BindingStats `json:"stats"`
+	SuccessRate       sdk.Dec      `json:"success_rate"`
+	AvgResponseHeight sdk.Dec      `json:"avg_response_height"`
+}
+
+func newBindingStatsOutput(stats BindingStats) BindingStatsOutput {
+	return BindingStatsOutput{
+		Stats:             stats,
+		SuccessRate:       stats.SuccessRate(),
+		AvgResponseHeight: stats.AvgResponseHeight(),
 	}
-	return bz, nil
 }
 
-type QueryBindingParams struct {
+type QueryEffectivePricesParams struct {
 	DefChainID  string
 	ServiceName string
 	BindChainId string
 	Provider    sdk.AccAddress
+	Consumer    sdk.AccAddress
+}
+
+type EffectivePricesOutput struct {
+	Prices []sdk.Coin `json:"prices"` // prices of all methods for the consumer
+	Volume uint64     `json:"volume"` // requests made by the consumer in the window of the volume tiers
+}
+
+func queryEffectivePrices(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryEffectivePricesParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+	svcBinding, found := k.GetServiceBinding(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider)
+	if !found {
+		return nil, ErrSvcBindingNotExists(DefaultCodespace)
+	}
+
+	output := EffectivePricesOutput{Prices: k.GetEffectivePrices(ctx, svcBinding, params.Consumer)}
+	if pricing := k.GetBindingPricing(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider); len(pricing.Tiers) > 0 {
+		output.Volume = k.GetConsumerVolume(ctx, svcBinding, params.Consumer, pricing.Window)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, output)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
 }
 
 func queryBinding(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
@@ -99,19 +225,59 @@ func queryBinding(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 	return bz, nil
 }
 
+func queryBindingStats(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBindingParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+	if _, found := k.GetServiceBinding(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider); !found {
+		return nil, ErrSvcBindingNotExists(DefaultCodespace)
+	}
+	stats := k.GetBindingStats(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider)
+	bz, err := codec.MarshalJSONIndent(k.cdc, newBindingStatsOutput(stats))
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+// MinSuccessRate is an optional decimal, only the bindings with finished requests
+// and a success rate not less than it are returned if specified
+type QueryBindingsParams struct {
+	DefChainID     string
+	ServiceName    string
+	MinSuccessRate string
+}
+
 func queryBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
-	var params QueryServiceParams
+	var params QueryBindingsParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, sdk.ParseParamsErr(err)
 	}
 
+	var minSuccessRate sdk.Dec
+	if len(params.MinSuccessRate) > 0 {
+		minSuccessRate, err = sdk.NewDecFromStr(params.MinSuccessRate)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(err.Error())
+		}
+	}
+
 	iterator := k.ServiceBindingsIterator(ctx, params.DefChainID, params.ServiceName)
 	defer iterator.Close()
 	var bindings []SvcBinding
 	for ; iterator.Valid(); iterator.Next() {
 		var binding SvcBinding
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &binding)
+
+		if len(params.MinSuccessRate) > 0 {
+			stats := k.GetBindingStats(ctx, binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider)
+			if stats.ServedRequests+stats.TimeoutRequests == 0 || stats.SuccessRate().LT(minSuccessRate) {
+				continue
+			}
+		}
 		bindings = append(bindings, binding)
 	}
 
@@ -145,6 +311,33 @@ func queryRequests(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sd
 	return bz, nil
 }
 
+type QueryRequestParams struct {
+	RequestId string
+}
+
+func queryRequest(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryRequestParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	eHeight, rHeight, counter, err := ConvertRequestID(params.RequestId)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	request, found := k.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	if !found {
+		return nil, ErrRequestNotActive(DefaultCodespace, params.RequestId)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, request)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
 type QueryResponseParams struct {
 	ReqChainId string
 	RequestId  string
@@ -205,3 +398,137 @@ func queryFees(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 	}
 	return bz, nil
 }
+
+type QueryConsumerHistoryParams struct {
+	Consumer sdk.AccAddress
+	Page     uint64
+	Size     uint16
+}
+
+func queryConsumerRequests(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryConsumerHistoryParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	requests := k.GetConsumerRequests(ctx, params.Consumer, params.Page, params.Size)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, requests)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryConsumerResponses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryConsumerHistoryParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	responses := k.GetConsumerResponses(ctx, params.Consumer, params.Page, params.Size)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, responses)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+type QueryMulticastRequestParams struct {
+	MulticastRequestId string
+}
+
+type MulticastRequestOutput struct {
+	MulticastRequest MulticastRequest        `json:"multicast_request"`
+	Status           string                  `json:"status"`
+	Requests         []MulticastRequestState `json:"requests"`
+	Responses        []SvcResponse           `json:"responses"`
+}
+
+func queryMulticastRequest(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryMulticastRequestParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	rHeight, counter, err := ConvertMulticastRequestID(params.MulticastRequestId)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	mreq, found := k.GetMulticastRequest(ctx, rHeight, counter)
+	if !found {
+		return nil, ErrNoMulticastRequestFound(DefaultCodespace, params.MulticastRequestId)
+	}
+
+	states := k.GetMulticastRequestStates(ctx, mreq)
+	output := MulticastRequestOutput{
+		MulticastRequest: mreq,
+		Status:           AggregateMulticastStatus(states),
+		Requests:         states,
+	}
+	for _, state := range states {
+		if state.Status != RequestStatusResponded {
+			continue
+		}
+		eHeight, rHeight, counter, _ := ConvertRequestID(state.RequestID)
+		if response, found := k.GetResponse(ctx, mreq.ReqChainID, eHeight, rHeight, counter); found {
+			output.Responses = append(output.Responses, response)
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, output)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+type QuerySubscriptionParams struct {
+	SubscriptionID uint64
+}
+
+func querySubscription(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySubscriptionParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	sub, found := k.GetSubscription(ctx, params.SubscriptionID)
+	if !found {
+		return nil, ErrSubscriptionNotExists(DefaultCodespace, params.SubscriptionID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, sub)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+type QuerySubscriptionsParams struct {
+	Consumer sdk.AccAddress
+}
+
+func querySubscriptions(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySubscriptionsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	subs := k.GetConsumerSubscriptions(ctx, params.Consumer)
+	if subs == nil {
+		subs = []Subscription{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, subs)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Subscription defines a request of a service method repeated every interval blocks,
+// the service fees are paid from the escrow funded on subscribing
+type Subscription struct {
+	ID             uint64         `json:"id"`
+	DefChainID     string         `json:"def_chain_id"`
+	DefName        string         `json:"def_name"`
+	BindChainID    string         `json:"bind_chain_id"`
+	ReqChainID     string         `json:"req_chain_id"`
+	MethodID       int16          `json:"method_id"`
+	Provider       sdk.AccAddress `json:"provider"`
+	Consumer       sdk.AccAddress `json:"consumer"`
+	Input          []byte         `json:"input"`
+	ServiceFee     sdk.Coins      `json:"service_fee"`     // the maximal service fee of each request
+	Interval       uint64         `json:"interval"`        // number of blocks between two requests
+	MaxRepetitions uint64         `json:"max_repetitions"` // number of requests to be sent
+	Repetitions    uint64         `json:"repetitions"`     // number of requests sent
+	Escrow         sdk.Coins      `json:"escrow"`          // the service fees left
+	NextHeight     int64          `json:"next_height"`     // block height of the next request
+	LastRequestID  string         `json:"last_request_id"`
+}
+
+func NewSubscription(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16,
+	input []byte, serviceFee sdk.Coins, interval, maxRepetitions uint64, escrow sdk.Coins) Subscription {
+	return Subscription{
+		DefChainID:     defChainID,
+		DefName:        defName,
+		BindChainID:    bindChainID,
+		ReqChainID:     reqChainID,
+		MethodID:       methodID,
+		Provider:       provider,
+		Consumer:       consumer,
+		Input:          input,
+		ServiceFee:     serviceFee,
+		Interval:       interval,
+		MaxRepetitions: maxRepetitions,
+		Escrow:         escrow,
+	}
+}
+
+// Finished returns true if all the requests have been sent
+func (s Subscription) Finished() bool {
+	return s.Repetitions >= s.MaxRepetitions
+}
@@ -5,13 +5,18 @@ import (
 )
 
 var (
-	ActionSvcCallTimeOut = []byte("service-call-expiration")
+	ActionSvcCallTimeOut         = []byte("service-call-expiration")
+	ActionSvcSubscriptionRequest = []byte("service-subscription-request")
+	ActionSvcSubscriptionClosed  = []byte("service-subscription-closed")
 
 	Action = sdk.TagAction
 
-	Provider   = "provider"
-	Consumer   = "consumer"
-	RequestID  = "request-id"
-	ServiceFee = "service-fee"
-	SlashCoins = "service-slash-coins"
+	Provider           = "provider"
+	Consumer           = "consumer"
+	RequestID          = "request-id"
+	MulticastRequestID = "multicast-request-id"
+	SubscriptionID     = "subscription-id"
+	DefVersion         = "def-version"
+	ServiceFee         = "service-fee"
+	SlashCoins         = "service-slash-coins"
 )
@@ -7,13 +7,20 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSvcDef{}, "irishub/service/MsgSvcDef", nil)
+	cdc.RegisterConcrete(MsgSvcDefVersion{}, "irishub/service/MsgSvcDefVersion", nil)
+	cdc.RegisterConcrete(MsgSvcDefDeprecate{}, "irishub/service/MsgSvcDefDeprecate", nil)
 	cdc.RegisterConcrete(MsgSvcBind{}, "irishub/service/MsgSvcBinding", nil)
 	cdc.RegisterConcrete(MsgSvcBindingUpdate{}, "irishub/service/MsgSvcBindingUpdate", nil)
+	cdc.RegisterConcrete(MsgSvcSetPricing{}, "irishub/service/MsgSvcSetPricing", nil)
 	cdc.RegisterConcrete(MsgSvcDisable{}, "irishub/service/MsgSvcDisable", nil)
 	cdc.RegisterConcrete(MsgSvcEnable{}, "irishub/service/MsgSvcEnable", nil)
 	cdc.RegisterConcrete(MsgSvcRefundDeposit{}, "irishub/service/MsgSvcRefundDeposit", nil)
 	cdc.RegisterConcrete(MsgSvcRequest{}, "irishub/service/MsgSvcRequest", nil)
+	cdc.RegisterConcrete(MsgSvcMulticastRequest{}, "irishub/service/MsgSvcMulticastRequest", nil)
 	cdc.RegisterConcrete(MsgSvcResponse{}, "irishub/service/MsgSvcResponse", nil)
+	cdc.RegisterConcrete(MsgSvcCancelRequest{}, "irishub/service/MsgSvcCancelRequest", nil)
+	cdc.RegisterConcrete(MsgSvcSubscribe{}, "irishub/service/MsgSvcSubscribe", nil)
+	cdc.RegisterConcrete(MsgSvcCancelSubscription{}, "irishub/service/MsgSvcCancelSubscription", nil)
 	cdc.RegisterConcrete(MsgSvcRefundFees{}, "irishub/service/MsgSvcRefundFees", nil)
 	cdc.RegisterConcrete(MsgSvcWithdrawFees{}, "irishub/service/MsgSvcWithdrawFees", nil)
 	cdc.RegisterConcrete(MsgSvcWithdrawTax{}, "irishub/service/MsgSvcWithdrawTax", nil)
@@ -23,6 +30,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(SvcBinding{}, "irishub/service/SvcBinding", nil)
 	cdc.RegisterConcrete(SvcRequest{}, "irishub/service/SvcRequest", nil)
 	cdc.RegisterConcrete(SvcResponse{}, "irishub/service/SvcResponse", nil)
+	cdc.RegisterConcrete(MulticastRequest{}, "irishub/service/MulticastRequest", nil)
+	cdc.RegisterConcrete(Subscription{}, "irishub/service/Subscription", nil)
 	cdc.RegisterConcrete(IncomingFee{}, "irishub/service/IncomingFee", nil)
 	cdc.RegisterConcrete(ReturnedFee{}, "irishub/service/ReturnedFee", nil)
 
@@ -31,9 +31,9 @@ func getMockApp(t *testing.T) (*mock.App, stake.Keeper, Keeper) {
 
 	paramsKeeper := params.NewKeeper(mApp.Cdc, mApp.KeyParams, mApp.TkeyParams)
 	stakeKeeper := stake.NewKeeper(mApp.Cdc, mApp.KeyStake, mApp.TkeyStake, bankKeeper, paramsKeeper.Subspace(stake.DefaultParamspace), stake.DefaultCodespace, stake.NopMetrics())
-	keeper := NewKeeper(mApp.Cdc, keySlashing, stakeKeeper, paramsKeeper.Subspace(DefaultParamspace), DefaultCodespace, NopMetrics())
+	keeper := NewKeeper(mApp.Cdc, keySlashing, stakeKeeper, bankKeeper, paramsKeeper.Subspace(DefaultParamspace), DefaultCodespace, NopMetrics())
 	mApp.Router().AddRoute("stake", []*sdk.KVStoreKey{mApp.KeyStake, mApp.KeyAccount, mApp.KeyParams}, stake.NewHandler(stakeKeeper))
-	mApp.Router().AddRoute("slashing", []*sdk.KVStoreKey{mApp.KeyStake, keySlashing, mApp.KeyParams}, NewHandler(keeper))
+	mApp.Router().AddRoute("slashing", []*sdk.KVStoreKey{mApp.KeyStake, mApp.KeyAccount, keySlashing, mApp.KeyParams}, NewHandler(keeper))
 
 	mApp.SetEndBlocker(getEndBlocker(stakeKeeper))
 	mApp.SetInitChainer(getInitChainer(mApp, stakeKeeper))
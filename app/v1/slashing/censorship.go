@@ -0,0 +1,94 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// CensorshipRecord - record of a proposer slashed for proposing a block with invalid txs
+type CensorshipRecord struct {
+	Height          int64           `json:"height"`
+	OperatorAddress sdk.ValAddress  `json:"operator_address"`
+	Address         sdk.ConsAddress `json:"address"`
+	InvalidTxHashes []cmn.HexBytes  `json:"invalid_tx_hashes"`
+	SlashedCoin     sdk.Coin        `json:"slashed_coin"`
+}
+
+// Return human readable censorship record
+func (r CensorshipRecord) String() string {
+	var txHashes bytes.Buffer
+	for _, txHash := range r.InvalidTxHashes {
+		txHashes.WriteString(fmt.Sprintf("\n    %s", txHash))
+	}
+	return fmt.Sprintf(`Censorship Record
+  Height:            %d
+  Operator Address:  %s
+  Address:           %s
+  Slashed Coin:      %s
+  Invalid Tx Hashes: %s`,
+		r.Height, r.OperatorAddress, r.Address, r.SlashedCoin, txHashes.String())
+}
+
+// CensorshipRecords - list of censorship records
+type CensorshipRecords []CensorshipRecord
+
+// Return human readable censorship records
+func (rs CensorshipRecords) String() string {
+	if len(rs) == 0 {
+		return "No censorship records"
+	}
+	var out bytes.Buffer
+	for i, r := range rs {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		out.WriteString(r.String())
+	}
+	return out.String()
+}
+
+// Stored by the height of the censored block
+func (k Keeper) getCensorshipRecord(ctx sdk.Context, height int64) (record CensorshipRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCensorshipRecordKey(height))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+func (k Keeper) setCensorshipRecord(ctx sdk.Context, record CensorshipRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetCensorshipRecordKey(record.Height), bz)
+}
+
+// Iterate over the censorship records from the oldest to the latest height
+func (k Keeper) iterateCensorshipRecords(ctx sdk.Context, handler func(record CensorshipRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, CensorshipRecordKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record CensorshipRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// Get the censorship records of all proposers, or of a single proposer if the address isn't empty
+func (k Keeper) getCensorshipRecords(ctx sdk.Context, address sdk.ConsAddress) CensorshipRecords {
+	records := CensorshipRecords{}
+	k.iterateCensorshipRecords(ctx, func(record CensorshipRecord) (stop bool) {
+		if address.Empty() || address.Equals(record.Address) {
+			records = append(records, record)
+		}
+		return false
+	})
+	return records
+}
@@ -7,6 +7,7 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "irishub/slashing/MsgUnjail", nil)
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "irishub/slashing/MsgSubmitEvidence", nil)
 	cdc.RegisterConcrete(&Params{}, "irishub/slashing/Params", nil)
 }
 
@@ -2,6 +2,8 @@
 package slashing
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -16,6 +18,13 @@ const (
 	CodeValidatorJailed       CodeType = 102
 	CodeValidatorNotJailed    CodeType = 103
 	CodeMissingSelfDelegation CodeType = 104
+	CodeSelfDelegationTooLow  CodeType = 105
+	CodeInvalidEvidence       CodeType = 106
+	CodeEvidenceTooOld        CodeType = 107
+	CodeDuplicateEvidence     CodeType = 108
+	CodeNoSigningInfoFound    CodeType = 109
+	CodeInvalidWindow         CodeType = 110
+	CodeNoCensorshipRecord    CodeType = 111
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -37,3 +46,31 @@ func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSelfDelegation, "validator has no self-delegation; cannot be unjailed")
 }
+
+func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegationTooLow, "validator's self delegation less than minimum; cannot be unjailed")
+}
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+func ErrEvidenceTooOld(codespace sdk.CodespaceType, age, maxAge int64) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceTooOld, fmt.Sprintf("evidence age %d is greater than the max evidence age %d", age, maxAge))
+}
+
+func ErrDuplicateEvidence(codespace sdk.CodespaceType, infractionHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateEvidence, fmt.Sprintf("the double sign at height %d has already been punished", infractionHeight))
+}
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSigningInfoFound, fmt.Sprintf("signing info of validator %s is not found", consAddr))
+}
+
+func ErrInvalidWindow(codespace sdk.CodespaceType, window, signedBlocksWindow int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWindow, fmt.Sprintf("window %d is greater than the signed blocks window %d", window, signedBlocksWindow))
+}
+
+func ErrNoCensorshipRecordFound(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoCensorshipRecord, fmt.Sprintf("censorship record at height %d is not found", height))
+}
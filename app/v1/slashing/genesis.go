@@ -32,6 +32,7 @@ func DefaultGenesisState() GenesisState {
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.GenesisState) {
+	data.Params = defaultMissingParams(data.Params)
 	if err := ValidateGenesis(data); err != nil {
 		panic(err.Error())
 	}
@@ -69,8 +70,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
-	var params Params
-	keeper.paramspace.GetParamSet(ctx, &params)
+	params := keeper.GetParamSet(ctx)
 
 	signingInfos := make(map[string]ValidatorSigningInfo)
 	missedBlocks := make(map[string][]MissedBlock)
@@ -103,9 +103,17 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 }
 
 func ValidateGenesis(data GenesisState) error {
-	err := validateParams(data.Params)
+	err := validateParams(defaultMissingParams(data.Params))
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// the params added later are missing in the genesis exported before, the defaults are used for them
+func defaultMissingParams(params Params) Params {
+	if params.EvidenceRewardFraction.IsNil() {
+		params.EvidenceRewardFraction = DefaultParams().EvidenceRewardFraction
+	}
+	return params
+}
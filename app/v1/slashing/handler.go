@@ -10,6 +10,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -30,6 +32,12 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		return ErrMissingSelfDelegation(k.codespace).Result()
 	}
 
+	// cannot be unjailed if the self-delegation is below the minimum
+	selfDelTokens := validator.GetTokens().Quo(validator.GetDelegatorShares()).Mul(selfDel.GetShares())
+	if selfDelTokens.LT(sdk.NewDecFromInt(validator.GetMinSelfDelegation())) {
+		return ErrSelfDelegationTooLowToUnjail(k.codespace).Result()
+	}
+
 	if !validator.GetJailed() {
 		return ErrValidatorNotJailed(k.codespace).Result()
 	}
@@ -55,3 +63,16 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		Tags: tags,
 	}
 }
+
+// Anyone can submit a pair of conflicting votes signed by a validator,
+// the validator is slashed and jailed as a double signer
+func handleMsgSubmitEvidence(ctx sdk.Context, msg MsgSubmitEvidence, k Keeper) sdk.Result {
+	tags, err := k.handleSubmittedEvidence(ctx, msg.Submitter, msg.VoteA, msg.VoteB)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
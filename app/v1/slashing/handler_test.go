@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/irisnet/irishub/app/v1/stake"
 	sdk "github.com/irisnet/irishub/types"
@@ -96,3 +100,167 @@ func TestJailedValidatorDelegations(t *testing.T) {
 	got = NewHandler(slashingKeeper)(ctx, NewMsgUnjail(valAddr))
 	require.True(t, got.IsOK(), "expected jailed validator to be able to unjail, got: %v", got)
 }
+
+func TestCannotUnjailBelowMinSelfDelegation(t *testing.T) {
+	ctx, _, stakeKeeper, _, slashingKeeper := createTestInput(t, DefaultParamsForTestnet())
+
+	stakeParams := stakeKeeper.GetParams(ctx)
+	stakeParams.UnbondingTime = 0
+	stakeKeeper.SetParams(ctx, stakeParams)
+
+	// create a validator with a minimum self-delegation equal to its self-delegation
+	amount := sdk.NewIntWithDecimal(10, 18)
+	valAddr, consAddr := addrs[0], sdk.ConsAddress(pks[0].Address())
+	msgCreateVal := NewTestMsgCreateValidator(valAddr, pks[0], amount)
+	msgCreateVal.MinSelfDelegation = amount
+	got := stake.NewHandler(stakeKeeper)(ctx, msgCreateVal)
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBlocker(ctx, stakeKeeper)
+
+	newInfo := ValidatorSigningInfo{
+		StartHeight:         int64(0),
+		IndexOffset:         int64(0),
+		JailedUntil:         time.Unix(0, 0),
+		MissedBlocksCounter: int64(0),
+	}
+	slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+
+	// unbond part of the self-delegation (which should jail the validator)
+	unbondShares := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18))
+	msgBeginUnbonding := stake.NewMsgBeginUnbonding(sdk.AccAddress(valAddr), valAddr, unbondShares)
+	got = stake.NewHandler(stakeKeeper)(ctx, msgBeginUnbonding)
+	require.True(t, got.IsOK(), "expected begin unbonding validator msg to be ok, got: %v", got)
+
+	validator, found := stakeKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.GetJailed())
+
+	// verify the validator cannot unjail itself below the minimum self-delegation
+	got = NewHandler(slashingKeeper)(ctx, NewMsgUnjail(valAddr))
+	require.False(t, got.IsOK(), "expected jailed validator to not be able to unjail, got: %v", got)
+	require.EqualValues(t, CodeSelfDelegationTooLow, got.Code)
+
+	// self-delegate back to the minimum
+	msgSelfDelegate := newTestMsgDelegate(sdk.AccAddress(valAddr), valAddr, sdk.NewIntWithDecimal(1, 18))
+	got = stake.NewHandler(stakeKeeper)(ctx, msgSelfDelegate)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+
+	// verify the validator can now unjail itself
+	got = NewHandler(slashingKeeper)(ctx, NewMsgUnjail(valAddr))
+	require.True(t, got.IsOK(), "expected jailed validator to be able to unjail, got: %v", got)
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	ctx, ck, sk, _, keeper := createTestInput(t, DefaultParamsForTestnet())
+	slh := NewHandler(keeper)
+
+	// create a validator with a consensus key which can sign votes
+	privKey := ed25519.GenPrivKey()
+	amt := sdk.NewIntWithDecimal(100, 18)
+	valAddr := addrs[0]
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(valAddr, privKey.PubKey(), amt))
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(2)
+
+	submitter := sdk.AccAddress(addrs[1])
+	voteA := newTestVote(t, privKey, ctx.ChainID(), 1, []byte("blockA"))
+	voteB := newTestVote(t, privKey, ctx.ChainID(), 1, []byte("blockB"))
+
+	// votes for the same block are not conflicting
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteA, voteA))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeInvalidEvidence, got.Code)
+
+	// votes with a bad signature are rejected
+	badVote := newTestVote(t, privKey, ctx.ChainID(), 1, []byte("blockB"))
+	badVote.Signature = voteA.Signature
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteA, badVote))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeInvalidEvidence, got.Code)
+
+	// evidence older than the max evidence age is rejected
+	got = slh(ctx.WithBlockHeight(2+keeper.MaxEvidenceAge(ctx)), NewMsgSubmitEvidence(submitter, voteA, voteB))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeEvidenceTooOld, got.Code)
+
+	// evidence is rejected if the power of the validator at the infraction height is unknown
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteA, voteB))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeInvalidEvidence, got.Code)
+
+	// the validator is slashed by the power at the infraction height and jailed,
+	// the submitter is rewarded from the slashed tokens and only the remainder is burned
+	power := int64(50)
+	keeper.setValidatorPower(ctx, sdk.ConsAddress(privKey.PubKey().Address()), 1, power)
+	tokens := keeper.loosenAndBondedTokens(ctx)
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteA, voteB))
+	require.True(t, got.IsOK(), "expected submit evidence msg to be ok, got: %v", got)
+
+	validator := sk.Validator(ctx, valAddr)
+	require.True(t, validator.GetJailed())
+	slashed := sdk.NewDecFromInt(sdk.NewIntWithDecimal(power, 18)).Mul(keeper.SlashFractionDoubleSign(ctx))
+	require.Equal(t, sdk.NewDecFromInt(amt).Sub(slashed), validator.GetTokens())
+
+	reward := slashed.Mul(keeper.EvidenceRewardFraction(ctx)).TruncateInt()
+	require.True(t, reward.IsPositive())
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sk.BondDenom(), initCoins.Add(reward))}, ck.GetCoins(ctx, submitter))
+	require.Equal(t, tokens.Sub(slashed).Add(sdk.NewDecFromInt(reward)), keeper.loosenAndBondedTokens(ctx))
+
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(privKey.PubKey().Address()))
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time.Add(keeper.DoubleSignJailDuration(ctx)), info.JailedUntil)
+
+	// the same double sign can't be submitted again
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteB, voteA))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeDuplicateEvidence, got.Code)
+
+	// the same double sign delivered by Tendermint is capped by the slashing period
+	keeper.handleDoubleSign(ctx, privKey.PubKey().Address(), 1, amt.Div(sdk.NewIntWithDecimal(1, 18)).Int64())
+	require.Equal(t, sdk.NewDecFromInt(amt).Sub(slashed), sk.Validator(ctx, valAddr).GetTokens())
+}
+
+func TestSubmitEvidencePunishedByTendermint(t *testing.T) {
+	ctx, ck, sk, _, keeper := createTestInput(t, DefaultParamsForTestnet())
+	slh := NewHandler(keeper)
+
+	privKey := ed25519.GenPrivKey()
+	amt := sdk.NewIntWithDecimal(100, 18)
+	valAddr := addrs[0]
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(valAddr, privKey.PubKey(), amt))
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(2)
+
+	// the double sign is punished by the evidence from Tendermint
+	keeper.setValidatorPower(ctx, sdk.ConsAddress(privKey.PubKey().Address()), 1, amt.Div(sdk.NewIntWithDecimal(1, 18)).Int64())
+	keeper.handleDoubleSign(ctx, privKey.PubKey().Address(), 1, amt.Div(sdk.NewIntWithDecimal(1, 18)).Int64())
+	validator := sk.Validator(ctx, valAddr)
+	require.True(t, validator.GetJailed())
+	tokens := validator.GetTokens()
+
+	// the evidence of the same double sign is rejected, and the submitter is not rewarded
+	submitter := sdk.AccAddress(addrs[1])
+	voteA := newTestVote(t, privKey, ctx.ChainID(), 1, []byte("blockA"))
+	voteB := newTestVote(t, privKey, ctx.ChainID(), 1, []byte("blockB"))
+	got = slh(ctx, NewMsgSubmitEvidence(submitter, voteA, voteB))
+	require.False(t, got.IsOK())
+	require.EqualValues(t, CodeDuplicateEvidence, got.Code)
+	require.Equal(t, tokens, sk.Validator(ctx, valAddr).GetTokens())
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sk.BondDenom(), initCoins)}, ck.GetCoins(ctx, submitter))
+}
+
+func newTestVote(t *testing.T, privKey crypto.PrivKey, chainID string, height int64, blockHash []byte) *tmtypes.Vote {
+	vote := &tmtypes.Vote{
+		Type:             tmtypes.PrecommitType,
+		Height:           height,
+		BlockID:          tmtypes.BlockID{Hash: tmhash.Sum(blockHash)},
+		Timestamp:        time.Unix(0, 0).UTC(),
+		ValidatorAddress: privKey.PubKey().Address(),
+	}
+	sig, err := privKey.Sign(vote.SignBytes(chainID))
+	require.Nil(t, err)
+	vote.Signature = sig
+	return vote
+}
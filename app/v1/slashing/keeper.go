@@ -2,18 +2,33 @@ package slashing
 
 import (
 	"fmt"
+	"github.com/irisnet/irishub/app/v1/bank"
 	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/app/v1/slashing/tags"
 	stake "github.com/irisnet/irishub/app/v1/stake/types"
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// ValidatorSet expected by the slashing keeper
+type ValidatorSet interface {
+	sdk.ValidatorSet
+
+	// slash the validator and delegators of the validator like Slash,
+	// but reward a fraction of the slashed tokens to the recipient and only burn the remainder
+	SlashAndReward(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec,
+		rewardFraction sdk.Dec, recipient sdk.AccAddress) (sdk.Tags, sdk.Int, sdk.Error)
+}
+
 // Keeper of the slashing store
 type Keeper struct {
 	storeKey     sdk.StoreKey
 	cdc          *codec.Codec
-	validatorSet sdk.ValidatorSet
+	validatorSet ValidatorSet
+	bk           bank.Keeper
 	paramspace   params.Subspace
 
 	// codespace
@@ -23,11 +38,12 @@ type Keeper struct {
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspace params.Subspace, codespace sdk.CodespaceType, metrics *Metrics) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs ValidatorSet, bk bank.Keeper, paramspace params.Subspace, codespace sdk.CodespaceType, metrics *Metrics) Keeper {
 	keeper := Keeper{
 		storeKey:     key,
 		cdc:          cdc,
 		validatorSet: vs,
+		bk:           bk,
 		paramspace:   paramspace.WithTypeTable(ParamTypeTable()),
 		codespace:    codespace,
 		metrics:      metrics,
@@ -39,7 +55,6 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspa
 // power: power of the double-signing validator at the height of infraction
 func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, power int64) (tags sdk.Tags) {
 	logger := ctx.Logger()
-	time := ctx.BlockHeader().Time
 	age := ctx.BlockHeight() - infractionHeight
 	consAddr := sdk.ConsAddress(addr)
 
@@ -70,6 +85,18 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	logger.Info("Validator double sign Confirmed", "validator", pubkey.Address(), "infraction_height", infractionHeight,
 		"age", age, "max_evidence_age", maxEvidenceAge)
 
+	tags, _, _ = k.slashAndJailDoubleSign(ctx, validator, infractionHeight, power, nil)
+	return
+}
+
+// slash and jail a validator for a confirmed double sign,
+// if the submitter of the evidence is given, it is rewarded from the slashed tokens
+func (k Keeper) slashAndJailDoubleSign(ctx sdk.Context, validator sdk.Validator, infractionHeight int64, power int64,
+	submitter sdk.AccAddress) (tags sdk.Tags, reward sdk.Int, err sdk.Error) {
+	logger := ctx.Logger()
+	time := ctx.BlockHeader().Time
+	consAddr := validator.GetConsAddr()
+
 	// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height.
 	// Note that this *can* result in a negative "distributionHeight", up to -ValidatorUpdateDelay,
 	// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
@@ -88,7 +115,15 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	// ABCI, and now received as evidence.
 	// The revisedFraction (which is the new fraction to be slashed) is passed
 	// in separately to separately slash unbonding and rebonding delegations.
-	tags = k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, revisedFraction)
+	if submitter == nil {
+		tags = k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, revisedFraction)
+		reward = sdk.ZeroInt()
+	} else {
+		tags, reward, err = k.validatorSet.SlashAndReward(ctx, consAddr, distributionHeight, power, revisedFraction, k.EvidenceRewardFraction(ctx), submitter)
+		if err != nil {
+			return nil, sdk.ZeroInt(), err
+		}
+	}
 
 	// Jail validator if not already jailed
 	if !validator.GetJailed() {
@@ -102,7 +137,74 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	}
 	signInfo.JailedUntil = time.Add(k.DoubleSignJailDuration(ctx))
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
-	return
+	return tags, reward, nil
+}
+
+// handle a pair of conflicting votes submitted by a transaction,
+// the submitter is rewarded with a fraction of the slashed tokens
+func (k Keeper) handleSubmittedEvidence(ctx sdk.Context, submitter sdk.AccAddress, voteA, voteB *tmtypes.Vote) (sdk.Tags, sdk.Error) {
+	logger := ctx.Logger()
+	consAddr := sdk.ConsAddress(voteA.ValidatorAddress)
+
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.GetStatus() == sdk.Unbonded {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
+
+	pubkey, err := k.getPubkey(ctx, voteA.ValidatorAddress)
+	if err != nil {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
+
+	evidence := &tmtypes.DuplicateVoteEvidence{PubKey: pubkey, VoteA: voteA, VoteB: voteB}
+	if err := evidence.Verify(ctx.ChainID(), pubkey); err != nil {
+		return nil, ErrInvalidEvidence(k.codespace, err.Error())
+	}
+
+	infractionHeight := evidence.Height()
+	if infractionHeight > ctx.BlockHeight() {
+		return nil, ErrInvalidEvidence(k.codespace, "infraction height is in the future")
+	}
+
+	age := ctx.BlockHeight() - infractionHeight
+	maxEvidenceAge := k.MaxEvidenceAge(ctx)
+	if age > maxEvidenceAge {
+		return nil, ErrEvidenceTooOld(k.codespace, age, maxEvidenceAge)
+	}
+
+	power, found := k.getValidatorPower(ctx, consAddr, infractionHeight)
+	if !found {
+		return nil, ErrInvalidEvidence(k.codespace, fmt.Sprintf("the power of the validator at height %d is unknown", infractionHeight))
+	}
+
+	// The submitted double sign of a validator is only punished once per height, and it is not punished
+	// if the slashing period has already been fully slashed, e.g. by the evidence from Tendermint
+	distributionHeight := infractionHeight - stake.ValidatorUpdateDelay
+	slashingPeriod := k.getValidatorSlashingPeriodForHeight(ctx, consAddr, distributionHeight)
+	if k.hasDoubleSign(ctx, consAddr, infractionHeight) || slashingPeriod.SlashedSoFar.GTE(k.SlashFractionDoubleSign(ctx)) {
+		return nil, ErrDuplicateEvidence(k.codespace, infractionHeight)
+	}
+	k.setDoubleSign(ctx, consAddr, infractionHeight)
+
+	logger.Info("Validator double sign confirmed by submitted evidence", "validator", pubkey.Address(),
+		"submitter", submitter.String(), "infraction_height", infractionHeight, "age", age, "max_evidence_age", maxEvidenceAge)
+
+	// Reward the submitter from the slashed tokens, the remainder is burned
+	resTags, reward, slashErr := k.slashAndJailDoubleSign(ctx, validator, infractionHeight, power, submitter)
+	if slashErr != nil {
+		return nil, slashErr
+	}
+	if reward.IsPositive() {
+		rewardCoins := sdk.Coins{sdk.NewCoin(stake.StakeDenom, reward)}
+		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, validator.GetOperator().String(), submitter.String(), rewardCoins.String(), sdk.EvidenceRewardFlow, "")
+	}
+
+	resTags = resTags.AppendTags(sdk.NewTags(
+		tags.Submitter, []byte(submitter.String()),
+		tags.Validator, []byte(validator.GetOperator().String()),
+		tags.Reward, []byte(reward.String()),
+	))
+	return resTags, nil
 }
 
 // handle a validator signature, must be called once per validator per block
@@ -180,7 +282,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 }
 
 // Punish proposer censorship by slashing malefactor's stake
-func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, infractionHeight int64) (tags sdk.Tags) {
+func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, infractionHeight int64, invalidTxs [][]byte) (tags sdk.Tags) {
 	logger := ctx.Logger()
 	time := ctx.BlockHeader().Time
 	consAddr := sdk.ConsAddress(addr)
@@ -208,7 +310,9 @@ func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, i
 	// ABCI, and now received as evidence.
 	// The revisedFraction (which is the new fraction to be slashed) is passed
 	// in separately to separately slash unbonding and rebonding delegations.
+	tokensBefore := k.loosenAndBondedTokens(ctx)
 	tags = k.validatorSet.Slash(ctx, consAddr, distributionHeight, validator.GetPower().RoundInt64(), k.SlashFractionCensorship(ctx))
+	slashedTokens := tokensBefore.Sub(k.loosenAndBondedTokens(ctx)).TruncateInt()
 
 	// Jail validator if not already jailed
 	if !validator.GetJailed() {
@@ -222,9 +326,38 @@ func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, i
 	}
 	signInfo.JailedUntil = time.Add(k.CensorshipJailDuration(ctx))
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	// Record the censorship for auditing
+	invalidTxHashes := make([]cmn.HexBytes, len(invalidTxs))
+	for i, txHash := range invalidTxs {
+		invalidTxHashes[i] = txHash
+	}
+	k.setCensorshipRecord(ctx, CensorshipRecord{
+		Height:          infractionHeight,
+		OperatorAddress: validator.GetOperator(),
+		Address:         consAddr,
+		InvalidTxHashes: invalidTxHashes,
+		SlashedCoin:     sdk.NewCoin(stake.StakeDenom, slashedTokens),
+	})
 	return
 }
 
+func (k Keeper) loosenAndBondedTokens(ctx sdk.Context) sdk.Dec {
+	loosenTokens := k.bk.GetLoosenCoins(ctx).AmountOf(stake.StakeDenom)
+	return k.validatorSet.TotalPower(ctx).Add(sdk.NewDecFromInt(loosenTokens))
+}
+
+func (k Keeper) hasDoubleSign(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDoubleSignKey(consAddr, infractionHeight))
+}
+
+func (k Keeper) setDoubleSign(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(true)
+	store.Set(GetDoubleSignKey(consAddr, infractionHeight), bz)
+}
+
 func (k Keeper) addPubkey(ctx sdk.Context, pubkey crypto.PubKey) {
 	addr := pubkey.Address()
 	k.setAddrPubkeyRelation(ctx, addr, pubkey)
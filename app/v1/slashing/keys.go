@@ -13,6 +13,9 @@ var (
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
+	DoubleSignKey                   = []byte{0x05} // Prefix for punished double signs
+	CensorshipRecordKey             = []byte{0x06} // Prefix for proposer censorship records
+	ValidatorPowerKey               = []byte{0x07} // Prefix for validator power history
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -57,3 +60,29 @@ func GetValidatorSlashingPeriodKey(v sdk.ConsAddress, startHeight int64) []byte
 func getAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// stored by *Tendermint* address (not operator address) followed by the infraction height
+func GetDoubleSignKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(append(DoubleSignKey, v.Bytes()...), b...)
+}
+
+// stored by the height of the censored block
+func GetCensorshipRecordKey(height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(CensorshipRecordKey, b...)
+}
+
+// stored by *Tendermint* address (not operator address)
+func GetValidatorPowerPrefix(v sdk.ConsAddress) []byte {
+	return append(ValidatorPowerKey, v.Bytes()...)
+}
+
+// stored by *Tendermint* address (not operator address) followed by the height from which the power is in effect
+func GetValidatorPowerKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetValidatorPowerPrefix(v), b...)
+}
@@ -3,6 +3,7 @@ package slashing
 import (
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 var cdc = codec.New()
@@ -12,6 +13,7 @@ const MsgRoute = "slashing"
 
 // verify interface at compile time
 var _ sdk.Msg = &MsgUnjail{}
+var _ sdk.Msg = &MsgSubmitEvidence{}
 
 // MsgUnjail - struct for unjailing jailed validator
 type MsgUnjail struct {
@@ -47,3 +49,53 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgSubmitEvidence - struct for submitting a pair of conflicting votes signed by a validator
+type MsgSubmitEvidence struct {
+	Submitter sdk.AccAddress `json:"submitter"` // address of the evidence submitter
+	VoteA     *tmtypes.Vote  `json:"vote_a"`
+	VoteB     *tmtypes.Vote  `json:"vote_b"`
+}
+
+func NewMsgSubmitEvidence(submitter sdk.AccAddress, voteA, voteB *tmtypes.Vote) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		Submitter: submitter,
+		VoteA:     voteA,
+		VoteB:     voteB,
+	}
+}
+
+//nolint
+func (msg MsgSubmitEvidence) Route() string { return MsgRoute }
+func (msg MsgSubmitEvidence) Type() string  { return "submit_evidence" }
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) == 0 {
+		return sdk.ErrInvalidAddress("submitter address is nil")
+	}
+	if msg.VoteA == nil || msg.VoteB == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "both votes must be provided")
+	}
+	if err := msg.VoteA.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	if err := msg.VoteB.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	return nil
+}
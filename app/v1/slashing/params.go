@@ -30,6 +30,7 @@ var (
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
 	KeySlashFractionCensorship = []byte("SlashFractionCensorship")
+	KeyEvidenceRewardFraction  = []byte("EvidenceRewardFraction")
 )
 
 // ParamTypeTable for slashing module
@@ -48,6 +49,7 @@ type Params struct {
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
 	SlashFractionCensorship sdk.Dec       `json:"slash_fraction_censorship"`
+	EvidenceRewardFraction  sdk.Dec       `json:"evidence_reward_fraction"`
 }
 
 func (p Params) String() string {
@@ -60,11 +62,13 @@ func (p Params) String() string {
   slashing/CensorshipJailDuration:   %s
   slashing/SlashFractionDoubleSign:  %s
   slashing/SlashFractionDowntime:    %s
-  slashing/SlashFractionCensorship:  %s`,
+  slashing/SlashFractionCensorship:  %s
+  slashing/EvidenceRewardFraction:   %s`,
 		p.MaxEvidenceAge, p.SignedBlocksWindow, p.MinSignedPerWindow.String(),
 		p.DoubleSignJailDuration, p.DowntimeJailDuration,
 		p.CensorshipJailDuration, p.SlashFractionDoubleSign.String(),
-		p.SlashFractionDowntime.String(), p.SlashFractionCensorship.String())
+		p.SlashFractionDowntime.String(), p.SlashFractionCensorship.String(),
+		p.EvidenceRewardFraction.String())
 }
 
 // Implements params.ParamStruct
@@ -79,6 +83,7 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign},
 		{KeySlashFractionDowntime, &p.SlashFractionDowntime},
 		{KeySlashFractionCensorship, &p.SlashFractionCensorship},
+		{KeyEvidenceRewardFraction, &p.EvidenceRewardFraction},
 	}
 }
 
@@ -165,6 +170,15 @@ func (p *Params) Validate(key string, value string) (interface{}, sdk.Error) {
 			return nil, err
 		}
 		return slashFractionCensorship, nil
+	case string(KeyEvidenceRewardFraction):
+		evidenceRewardFraction, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateEvidenceRewardFraction(evidenceRewardFraction); err != nil {
+			return nil, err
+		}
+		return evidenceRewardFraction, nil
 	default:
 		return nil, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf("%s is not found", key))
 	}
@@ -203,6 +217,9 @@ func (p *Params) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (st
 	case string(KeySlashFractionCensorship):
 		err := cdc.UnmarshalJSON(bytes, &p.SlashFractionCensorship)
 		return p.SlashFractionCensorship.String(), err
+	case string(KeyEvidenceRewardFraction):
+		err := cdc.UnmarshalJSON(bytes, &p.EvidenceRewardFraction)
+		return p.EvidenceRewardFraction.String(), err
 	default:
 		return "", fmt.Errorf("%s is not existed", key)
 	}
@@ -224,6 +241,7 @@ func DefaultParams() Params {
 		SlashFractionDoubleSign: sdk.NewDecWithPrec(1, 2),
 		SlashFractionDowntime:   sdk.ZeroDec(),
 		SlashFractionCensorship: sdk.ZeroDec(),
+		EvidenceRewardFraction:  sdk.NewDecWithPrec(1, 1),
 	}
 }
 
@@ -238,6 +256,7 @@ func DefaultParamsForTestnet() Params {
 		SlashFractionDoubleSign: sdk.NewDecWithPrec(5, 2),
 		SlashFractionDowntime:   sdk.ZeroDec(),
 		SlashFractionCensorship: sdk.ZeroDec(),
+		EvidenceRewardFraction:  sdk.NewDecWithPrec(1, 1),
 	}
 }
 
@@ -269,6 +288,9 @@ func validateParams(p Params) sdk.Error {
 	if err := validateSlashFractionCensorship(p.SlashFractionCensorship); err != nil {
 		return err
 	}
+	if err := validateEvidenceRewardFraction(p.EvidenceRewardFraction); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -339,12 +361,20 @@ func validateSlashFractionCensorship(p sdk.Dec) sdk.Error {
 	return nil
 }
 
+func validateEvidenceRewardFraction(p sdk.Dec) sdk.Error {
+	if p.LT(sdk.ZeroDec()) || p.GT(sdk.NewDecWithPrec(5, 1)) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSlashParams, fmt.Sprintf("Slash EvidenceRewardFraction [%s] should be between [0, 0.5] ", p.String()))
+	}
+	return nil
+}
+
 //______________________________________________________________________
 
 // get inflation params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) Params {
-	var params Params
-	k.paramspace.GetParamSet(ctx, &params)
+	// the params added after the chain started are not stored, the defaults are used for them
+	params := DefaultParams()
+	k.paramspace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -408,3 +438,11 @@ func (k Keeper) SlashFractionCensorship(ctx sdk.Context) (res sdk.Dec) {
 	k.paramspace.Get(ctx, KeySlashFractionCensorship, &res)
 	return
 }
+
+// Fraction of the slashed tokens rewarded to the submitter of the evidence
+func (k Keeper) EvidenceRewardFraction(ctx sdk.Context) (res sdk.Dec) {
+	// the parameter is not stored on the chains started before it was added
+	res = DefaultParams().EvidenceRewardFraction
+	k.paramspace.GetIfExists(ctx, KeyEvidenceRewardFraction, &res)
+	return
+}
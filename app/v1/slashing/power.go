@@ -0,0 +1,61 @@
+package slashing
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Stored by *validator* address (not operator address)
+// Record the power of a validator in the validator set at a particular height,
+// only the changes of the power are stored
+func (k Keeper) setValidatorPower(ctx sdk.Context, address sdk.ConsAddress, height int64, power int64) {
+	if lastPower, found := k.getValidatorPower(ctx, address, height); found && lastPower == power {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(power)
+	store.Set(GetValidatorPowerKey(address, height), bz)
+
+	// The powers are only needed for the evidences within the max evidence age
+	k.pruneValidatorPowers(ctx, address, height-k.MaxEvidenceAge(ctx))
+}
+
+// Stored by *validator* address (not operator address)
+// Get the power of a validator at a particular height, which is the most recent power recorded at or before the height
+func (k Keeper) getValidatorPower(ctx sdk.Context, address sdk.ConsAddress, height int64) (power int64, found bool) {
+	if height < 0 {
+		return 0, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := GetValidatorPowerPrefix(address)
+	end := sdk.PrefixEndBytes(GetValidatorPowerKey(address, height))
+	iterator := store.ReverseIterator(start, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &power)
+	return power, true
+}
+
+// Stored by *validator* address (not operator address)
+// Delete the powers recorded before a particular height, except the one still in effect at that height
+func (k Keeper) pruneValidatorPowers(ctx sdk.Context, address sdk.ConsAddress, height int64) {
+	if height < 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	start := GetValidatorPowerPrefix(address)
+	end := sdk.PrefixEndBytes(GetValidatorPowerKey(address, height))
+	iterator := store.ReverseIterator(start, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return
+	}
+	var keys [][]byte
+	for iterator.Next(); iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
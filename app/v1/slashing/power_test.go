@@ -0,0 +1,44 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func TestGetSetValidatorPower(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParamsForTestnet())
+	addr := sdk.ConsAddress(addrs[0])
+	maxEvidenceAge := keeper.MaxEvidenceAge(ctx)
+
+	// no power before the first record
+	_, found := keeper.getValidatorPower(ctx, addr, 5)
+	require.False(t, found)
+
+	keeper.setValidatorPower(ctx, addr, 5, 10)
+	_, found = keeper.getValidatorPower(ctx, addr, 4)
+	require.False(t, found)
+	power, found := keeper.getValidatorPower(ctx, addr, 5)
+	require.True(t, found)
+	require.Equal(t, int64(10), power)
+
+	// the power is in effect until it changes
+	keeper.setValidatorPower(ctx, addr, 6, 10)
+	keeper.setValidatorPower(ctx, addr, 8, 20)
+	power, _ = keeper.getValidatorPower(ctx, addr, 7)
+	require.Equal(t, int64(10), power)
+	power, _ = keeper.getValidatorPower(ctx, addr, 100)
+	require.Equal(t, int64(20), power)
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(GetValidatorPowerKey(addr, 6)))
+
+	// the powers older than the max evidence age are pruned, except the one still in effect
+	keeper.setValidatorPower(ctx, addr, 9+maxEvidenceAge, 30)
+	_, found = keeper.getValidatorPower(ctx, addr, 7)
+	require.False(t, found)
+	power, _ = keeper.getValidatorPower(ctx, addr, 9)
+	require.Equal(t, int64(20), power)
+	power, _ = keeper.getValidatorPower(ctx, addr, 9+maxEvidenceAge)
+	require.Equal(t, int64(30), power)
+}
@@ -0,0 +1,181 @@
+package slashing
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryMissedBlocks = "missedBlocks"
+	QueryUptime       = "uptime"
+	QueryUptimes      = "uptimes"
+	QueryCensorship   = "censorship"
+	QueryCensorships  = "censorships"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryMissedBlocks:
+			return queryMissedBlocks(ctx, req, k)
+		case QueryUptime:
+			return queryUptime(ctx, req, k)
+		case QueryUptimes:
+			return queryUptimes(ctx, req, k)
+		case QueryCensorship:
+			return queryCensorship(ctx, req, k)
+		case QueryCensorships:
+			return queryCensorships(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/missedBlocks'
+type QueryMissedBlocksParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/uptime'
+type QueryUptimeParams struct {
+	ConsAddress sdk.ConsAddress
+	Window      int64 // the signed blocks window if not positive
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/uptimes'
+type QueryUptimesParams struct {
+	Window int64 // the signed blocks window if not positive
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/censorship'
+type QueryCensorshipParams struct {
+	Height int64
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/censorships'
+type QueryCensorshipsParams struct {
+	ConsAddress sdk.ConsAddress // all proposers if empty
+}
+
+func queryMissedBlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryMissedBlocksParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	info, found := k.getValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoSigningInfoFound(k.codespace, params.ConsAddress)
+	}
+
+	missedBlocks := ValidatorMissedBlocks{
+		Address:             params.ConsAddress,
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+		MissedBlocks:        k.getValidatorMissedBlocks(ctx, params.ConsAddress, info, k.SignedBlocksWindow(ctx)),
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, missedBlocks)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryUptime(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryUptimeParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	window, err := k.getUptimeWindow(ctx, params.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, params.ConsAddress)
+	if validator == nil {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
+
+	info, found := k.getValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoSigningInfoFound(k.codespace, params.ConsAddress)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(k.cdc, k.getValidatorUptime(ctx, validator, info, window))
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+func queryUptimes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryUptimesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	window, err := k.getUptimeWindow(ctx, params.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(k.cdc, k.getBondedValidatorUptimes(ctx, window))
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+func queryCensorship(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryCensorshipParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	record, found := k.getCensorshipRecord(ctx, params.Height)
+	if !found {
+		return nil, ErrNoCensorshipRecordFound(k.codespace, params.Height)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, record)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryCensorships(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryCensorshipsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.getCensorshipRecords(ctx, params.ConsAddress))
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+// the uptime can only be counted in the signed blocks window
+func (k Keeper) getUptimeWindow(ctx sdk.Context, window int64) (int64, sdk.Error) {
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	if window <= 0 {
+		return signedBlocksWindow, nil
+	}
+	if window > signedBlocksWindow {
+		return 0, ErrInvalidWindow(k.codespace, window, signedBlocksWindow)
+	}
+	return window, nil
+}
@@ -0,0 +1,152 @@
+package slashing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/irisnet/irishub/app/protocol"
+	"github.com/irisnet/irishub/app/v1/stake"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func TestQueryMissedBlocksAndUptimes(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParamsForTestnet())
+	querier := NewQuerier(keeper)
+	window := keeper.SignedBlocksWindow(ctx)
+
+	// create two validators
+	for i, amt := range []sdk.Int{sdk.NewIntWithDecimal(100, 18), sdk.NewIntWithDecimal(50, 18)} {
+		got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addrs[i], pks[i], amt))
+		require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	}
+	stake.EndBlocker(ctx, sk)
+
+	// the first validator signs all the blocks, the second one misses the blocks at even heights
+	height := window + 50
+	for h := int64(1); h <= height; h++ {
+		ctx = ctx.WithBlockHeight(h)
+		keeper.handleValidatorSignature(ctx, pks[0].Address(), 100, true)
+		keeper.handleValidatorSignature(ctx, pks[1].Address(), 50, h%2 == 1)
+	}
+
+	// query the missed blocks of the second validator, the bit array has been wrapped
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, QueryMissedBlocks),
+		Data: keeper.cdc.MustMarshalJSON(QueryMissedBlocksParams{ConsAddress: sdk.ConsAddress(pks[1].Address())}),
+	}
+	res, err := querier(ctx, []string{QueryMissedBlocks}, req)
+	require.Nil(t, err)
+	var missedBlocks ValidatorMissedBlocks
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &missedBlocks))
+	require.Equal(t, height, missedBlocks.IndexOffset)
+	require.Equal(t, window/2, missedBlocks.MissedBlocksCounter)
+	require.Len(t, missedBlocks.MissedBlocks, int(window))
+	for i, missed := range missedBlocks.MissedBlocks {
+		require.Equal(t, (height-window+1+int64(i))%2 == 0, missed, "block %d of the window", i)
+	}
+
+	// query the uptime of the second validator in the latest 11 blocks
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, QueryUptime),
+		Data: keeper.cdc.MustMarshalJSON(QueryUptimeParams{ConsAddress: sdk.ConsAddress(pks[1].Address()), Window: 11}),
+	}
+	res, err = querier(ctx, []string{QueryUptime}, req)
+	require.Nil(t, err)
+	var uptime ValidatorUptime
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &uptime))
+	require.Equal(t, addrs[1], uptime.OperatorAddress)
+	require.Equal(t, int64(11), uptime.Window)
+	require.Equal(t, int64(5), uptime.SignedBlocks)
+	require.Equal(t, int64(6), uptime.MissedBlocks)
+	require.Equal(t, sdk.NewDec(5).Quo(sdk.NewDec(11)), uptime.Uptime)
+
+	// the window can't be greater than the signed blocks window
+	req.Data = keeper.cdc.MustMarshalJSON(QueryUptimeParams{ConsAddress: sdk.ConsAddress(pks[1].Address()), Window: window + 1})
+	_, err = querier(ctx, []string{QueryUptime}, req)
+	require.NotNil(t, err)
+	require.EqualValues(t, CodeInvalidWindow, err.Code())
+
+	// rank the bonded validators by uptime in the signed blocks window
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, QueryUptimes),
+		Data: keeper.cdc.MustMarshalJSON(QueryUptimesParams{}),
+	}
+	res, err = querier(ctx, []string{QueryUptimes}, req)
+	require.Nil(t, err)
+	var uptimes ValidatorUptimes
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &uptimes))
+	require.Len(t, uptimes, 2)
+	require.Equal(t, addrs[0], uptimes[0].OperatorAddress)
+	require.Equal(t, sdk.OneDec(), uptimes[0].Uptime)
+	require.Equal(t, addrs[1], uptimes[1].OperatorAddress)
+	require.Equal(t, window, uptimes[1].Window)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), uptimes[1].Uptime)
+}
+
+func TestQueryCensorships(t *testing.T) {
+	params := DefaultParamsForTestnet()
+	params.SlashFractionCensorship = sdk.NewDecWithPrec(5, 2)
+	ctx, _, sk, _, keeper := createTestInput(t, params)
+	querier := NewQuerier(keeper)
+
+	amt := sdk.NewIntWithDecimal(100, 18)
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addrs[0], pks[0], amt))
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// the proposer packs an invalid tx into the block
+	invalidTx := []byte("invalid tx hash")
+	validTxCounter := sdk.NewValidTxCounter()
+	validTxCounter.AddInvalidTx(invalidTx)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 2, NumTxs: 1, ProposerAddress: pks[0].Address()}).
+		WithBlockHeight(2).
+		WithCheckValidNum(validTxCounter)
+	EndBlocker(ctx, abci.RequestEndBlock{Height: 2}, keeper)
+
+	validator := sk.Validator(ctx, addrs[0])
+	require.True(t, validator.GetJailed())
+
+	// query the censorship at the height
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, QueryCensorship),
+		Data: keeper.cdc.MustMarshalJSON(QueryCensorshipParams{Height: 2}),
+	}
+	res, err := querier(ctx, []string{QueryCensorship}, req)
+	require.Nil(t, err)
+	var record CensorshipRecord
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &record))
+	require.Equal(t, int64(2), record.Height)
+	require.Equal(t, addrs[0], record.OperatorAddress)
+	require.Equal(t, sdk.ConsAddress(pks[0].Address()), record.Address)
+	require.Equal(t, []cmn.HexBytes{invalidTx}, record.InvalidTxHashes)
+	slashed := sdk.NewDecFromInt(amt).Mul(params.SlashFractionCensorship).TruncateInt()
+	require.Equal(t, sdk.NewCoin(sk.BondDenom(), slashed), record.SlashedCoin)
+
+	// no censorship at other heights
+	req.Data = keeper.cdc.MustMarshalJSON(QueryCensorshipParams{Height: 3})
+	_, err = querier(ctx, []string{QueryCensorship}, req)
+	require.NotNil(t, err)
+	require.EqualValues(t, CodeNoCensorshipRecord, err.Code())
+
+	// query the censorships of the proposer and of another validator
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, QueryCensorships),
+		Data: keeper.cdc.MustMarshalJSON(QueryCensorshipsParams{ConsAddress: sdk.ConsAddress(pks[0].Address())}),
+	}
+	res, err = querier(ctx, []string{QueryCensorships}, req)
+	require.Nil(t, err)
+	var records CensorshipRecords
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &records))
+	require.Equal(t, CensorshipRecords{record}, records)
+
+	req.Data = keeper.cdc.MustMarshalJSON(QueryCensorshipsParams{ConsAddress: sdk.ConsAddress(pks[1].Address())})
+	res, err = querier(ctx, []string{QueryCensorships}, req)
+	require.Nil(t, err)
+	records = nil
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &records))
+	require.Empty(t, records)
+}
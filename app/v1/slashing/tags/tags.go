@@ -0,0 +1,8 @@
+// nolint
+package tags
+
+var (
+	Validator = "validator"
+	Submitter = "submitter"
+	Reward    = "reward"
+)
@@ -88,7 +88,7 @@ func createTestInput(t *testing.T, defaults Params) (sdk.Context, bank.Keeper, s
 	}
 	require.Nil(t, err)
 	paramstore := paramsKeeper.Subspace(DefaultParamspace)
-	keeper := NewKeeper(cdc, keySlashing, &sk, paramstore, DefaultCodespace, NopMetrics())
+	keeper := NewKeeper(cdc, keySlashing, &sk, ck, paramstore, DefaultCodespace, NopMetrics())
 	sk.SetHooks(keeper.Hooks())
 
 	require.NotPanics(t, func() {
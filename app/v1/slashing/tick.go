@@ -17,8 +17,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) (tags
 
 	// Iterate over all the validators  which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
-	// which have missed too many blocks in a row (downtime slashing)
+	// which have missed too many blocks in a row (downtime slashing),
+	// and record their powers for the evidences submitted by transactions
 	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		sk.setValidatorPower(ctx, sdk.ConsAddress(voteInfo.Validator.Address), req.Header.Height-1, voteInfo.Validator.Power)
 		absenceSlashTags := sk.handleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 		tags = tags.AppendTags(absenceSlashTags)
 	}
@@ -49,7 +51,8 @@ func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, sk Keeper) (tags sdk.
 	if ctx.ValidTxCounter().Count() < ctx.BlockHeader().NumTxs {
 		proposalCensorshipTag := sk.handleProposerCensorship(ctx,
 			ctx.BlockHeader().ProposerAddress,
-			ctx.BlockHeight())
+			ctx.BlockHeight(),
+			ctx.ValidTxCounter().InvalidTxs())
 		tags = tags.AppendTags(proposalCensorshipTag)
 	}
 	return
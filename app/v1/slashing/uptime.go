@@ -0,0 +1,131 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// ValidatorMissedBlocks - missed-block bitmap of a validator in the signed blocks window
+type ValidatorMissedBlocks struct {
+	Address             sdk.ConsAddress `json:"address"`
+	StartHeight         int64           `json:"start_height"`
+	IndexOffset         int64           `json:"index_offset"`
+	MissedBlocksCounter int64           `json:"missed_blocks_counter"`
+	MissedBlocks        []bool          `json:"missed_blocks"` // from the oldest to the latest block in the window
+}
+
+// Return human readable missed blocks, a missed block is marked with 'x' and a signed block with '.'
+func (m ValidatorMissedBlocks) String() string {
+	var bitmap bytes.Buffer
+	for i, missed := range m.MissedBlocks {
+		if i > 0 && i%100 == 0 {
+			bitmap.WriteString("\n  ")
+		}
+		if missed {
+			bitmap.WriteByte('x')
+		} else {
+			bitmap.WriteByte('.')
+		}
+	}
+	return fmt.Sprintf(`Missed Blocks
+  Address:               %s
+  Start Height:          %d
+  Index Offset:          %d
+  Missed Blocks Counter: %d
+  Missed Blocks (from the oldest to the latest, x: missed, .: signed):
+  %s`,
+		m.Address, m.StartHeight, m.IndexOffset, m.MissedBlocksCounter, bitmap.String())
+}
+
+// ValidatorUptime - uptime of a validator over the latest blocks of a window
+type ValidatorUptime struct {
+	OperatorAddress sdk.ValAddress  `json:"operator_address"`
+	Address         sdk.ConsAddress `json:"address"`
+	Window          int64           `json:"window"` // number of blocks counted, not more than the blocks since the start height
+	SignedBlocks    int64           `json:"signed_blocks"`
+	MissedBlocks    int64           `json:"missed_blocks"`
+	Uptime          sdk.Dec         `json:"uptime"`
+}
+
+// Return human readable uptime
+func (u ValidatorUptime) String() string {
+	return fmt.Sprintf(`Uptime
+  Operator Address: %s
+  Address:          %s
+  Window:           %d
+  Signed Blocks:    %d
+  Missed Blocks:    %d
+  Uptime:           %s`,
+		u.OperatorAddress, u.Address, u.Window, u.SignedBlocks, u.MissedBlocks, u.Uptime)
+}
+
+// ValidatorUptimes - validators ranked by uptime
+type ValidatorUptimes []ValidatorUptime
+
+// Return human readable validator uptimes
+func (us ValidatorUptimes) String() string {
+	var out bytes.Buffer
+	out.WriteString(fmt.Sprintf("%-6s %-45s %-8s %-8s %-8s %s\n", "Rank", "Operator Address", "Window", "Signed", "Missed", "Uptime"))
+	for i, u := range us {
+		out.WriteString(fmt.Sprintf("%-6d %-45s %-8d %-8d %-8d %s\n", i+1, u.OperatorAddress, u.Window, u.SignedBlocks, u.MissedBlocks, u.Uptime))
+	}
+	return out.String()
+}
+
+// get the missed-block bitmap of the latest blocks in the signed blocks window, from the oldest to the latest
+func (k Keeper) getValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress, info ValidatorSigningInfo, window int64) []bool {
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	if window > info.IndexOffset {
+		window = info.IndexOffset
+	}
+
+	missedBlocks := make([]bool, 0, window)
+	for offset := info.IndexOffset - window; offset < info.IndexOffset; offset++ {
+		missedBlocks = append(missedBlocks, k.getValidatorMissedBlockBitArray(ctx, address, offset%signedBlocksWindow))
+	}
+	return missedBlocks
+}
+
+// get the uptime of a validator over the latest blocks of the window
+func (k Keeper) getValidatorUptime(ctx sdk.Context, validator sdk.Validator, info ValidatorSigningInfo, window int64) ValidatorUptime {
+	missedBlocks := k.getValidatorMissedBlocks(ctx, validator.GetConsAddr(), info, window)
+
+	uptime := ValidatorUptime{
+		OperatorAddress: validator.GetOperator(),
+		Address:         validator.GetConsAddr(),
+		Window:          int64(len(missedBlocks)),
+		Uptime:          sdk.OneDec(),
+	}
+	for _, missed := range missedBlocks {
+		if missed {
+			uptime.MissedBlocks++
+		} else {
+			uptime.SignedBlocks++
+		}
+	}
+	if uptime.Window > 0 {
+		uptime.Uptime = sdk.NewDec(uptime.SignedBlocks).Quo(sdk.NewDec(uptime.Window))
+	}
+	return uptime
+}
+
+// get the uptimes of all bonded validators, ranked by uptime and then by power
+func (k Keeper) getBondedValidatorUptimes(ctx sdk.Context, window int64) ValidatorUptimes {
+	uptimes := ValidatorUptimes{}
+	k.validatorSet.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
+		info, found := k.getValidatorSigningInfo(ctx, validator.GetConsAddr())
+		if !found {
+			return false
+		}
+		uptimes = append(uptimes, k.getValidatorUptime(ctx, validator, info, window))
+		return false
+	})
+
+	sort.SliceStable(uptimes, func(i, j int) bool {
+		return uptimes[i].Uptime.GT(uptimes[j].Uptime)
+	})
+	return uptimes
+}
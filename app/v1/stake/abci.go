@@ -56,3 +56,17 @@ func EndBlocker(ctx types.Context, k keeper.Keeper) (validatorUpdates []types2.V
 	k.UpdateMetrics(ctx)
 	return
 }
+
+// ApplyMatureCommissions applies all the announced commissions which have taken effect
+func ApplyMatureCommissions(ctx types.Context, k keeper.Keeper) (resTags types.Tags) {
+	ctx = ctx.WithLogger(ctx.Logger().With("handler", "endBlock").With("module", "iris/stake"))
+	resTags = types.EmptyTags()
+	for _, validator := range k.ApplyAllMatureCommissionQueue(ctx) {
+		resTags = resTags.AppendTags(types.NewTags(
+			tags.Action, tags.ActionApplyCommission,
+			tags.DstValidator, []byte(validator.OperatorAddr.String()),
+			tags.CommissionRate, []byte(validator.Commission.Rate.String()),
+		))
+	}
+	return
+}
@@ -15,6 +15,7 @@ import (
 // setting the indexes. In addition, it also sets any delegations found in
 // data. Finally, it updates the bonded validators.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res []abci.ValidatorUpdate, err error) {
+	data.Params = defaultMissingParams(data.Params)
 	if err := ValidateGenesis(data); err != nil {
 		panic(err.Error())
 	}
@@ -41,6 +42,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 			keeper.InsertValidatorQueue(ctx, validator)
 		}
 
+		// Set the pending commission timeslice if necessary
+		if validator.PendingCommission != nil {
+			keeper.InsertCommissionQueue(ctx, validator)
+		}
+
 		// Increase loosen token
 		if validator.Status != sdk.Bonded {
 			balance := sdk.NewCoin(types.StakeDenom, validator.Tokens.TruncateInt())
@@ -150,7 +156,7 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
-	err = types.ValidateParams(data.Params)
+	err = types.ValidateParams(defaultMissingParams(data.Params))
 	if err != nil {
 		return err
 	}
@@ -158,6 +164,15 @@ func ValidateGenesis(data types.GenesisState) error {
 	return nil
 }
 
+// the params added later are missing in the genesis exported before, the defaults are used for them.
+// A zero CommissionNoticePeriod is only treated as missing on the mainnet, where it is not a valid value
+func defaultMissingParams(params types.Params) types.Params {
+	if params.CommissionNoticePeriod == 0 && sdk.NetworkType == sdk.Mainnet {
+		params.CommissionNoticePeriod = types.DefaultParams().CommissionNoticePeriod
+	}
+	return params
+}
+
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 	for i := 0; i < len(validators); i++ {
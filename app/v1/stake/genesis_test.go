@@ -3,6 +3,7 @@ package stake
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -146,3 +147,18 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGenesisMissingCommissionNoticePeriod(t *testing.T) {
+	networkType := sdk.NetworkType
+	sdk.NetworkType = sdk.Mainnet
+	defer func() { sdk.NetworkType = networkType }()
+
+	// the genesis exported before the parameter was added decodes it as zero
+	genesisState := types.DefaultGenesisState()
+	genesisState.Params.CommissionNoticePeriod = 0
+	require.NoError(t, ValidateGenesis(genesisState))
+	require.Equal(t, types.DefaultParams().CommissionNoticePeriod, defaultMissingParams(genesisState.Params).CommissionNoticePeriod)
+
+	sdk.NetworkType = sdk.Testnet
+	require.Equal(t, time.Duration(0), defaultMissingParams(genesisState.Params).CommissionNoticePeriod)
+}
@@ -25,6 +25,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginRedelegate(ctx, msg, k)
 		case types.MsgBeginUnbonding:
 			return handleMsgBeginUnbonding(ctx, msg, k)
+		case types.MsgTransferDelegation:
+			return handleMsgTransferDelegation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -69,6 +71,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	if err != nil {
 		return err.Result()
 	}
+	// no minimum self-delegation is required if it is not given
+	if msg.MinSelfDelegation != (sdk.Int{}) {
+		validator.MinSelfDelegation = msg.MinSelfDelegation
+	}
 
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
@@ -112,15 +118,37 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 
 	validator.Description = description
 
+	// the commission change is announced and applied in the end blocker after the notice period
+	commissionTags := sdk.EmptyTags()
 	if msg.CommissionRate != nil {
-		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+		pendingCommission, err := k.AnnounceValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return err.Result()
 		}
-		ctx.Logger().Debug("Update validator commission rate", "validator_addr", msg.ValidatorAddr.String(),
-			"commission_rate", msg.CommissionRate.String())
-		validator.Commission = commission
-		k.OnValidatorModified(ctx, msg.ValidatorAddr)
+		ctx.Logger().Debug("Announce validator commission rate", "validator_addr", msg.ValidatorAddr.String(),
+			"commission_rate", msg.CommissionRate.String(), "effective_time", pendingCommission.EffectiveTime.String())
+		validator.PendingCommission = &pendingCommission
+		k.InsertCommissionQueue(ctx, validator)
+		commissionTags = sdk.NewTags(
+			tags.CommissionRate, []byte(pendingCommission.Rate.String()),
+			tags.EffectiveTime, []byte(pendingCommission.EffectiveTime.String()),
+		)
+	}
+
+	// the minimum self-delegation can only be raised up to the current self-delegation
+	minSelfDelegationTags := sdk.EmptyTags()
+	if msg.MinSelfDelegation != nil {
+		if !msg.MinSelfDelegation.GT(validator.MinSelfDelegation) {
+			return ErrMinSelfDelegationDecreased(k.Codespace()).Result()
+		}
+		validator.MinSelfDelegation = *msg.MinSelfDelegation
+		selfDelegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.OperatorAddr), validator.OperatorAddr)
+		if !found || validator.SelfDelegationBelowMinimum(selfDelegation.Shares) {
+			return ErrSelfDelegationBelowMinimum(k.Codespace()).Result()
+		}
+		minSelfDelegationTags = sdk.NewTags(
+			tags.MinSelfDelegation, []byte(msg.MinSelfDelegation.String()),
+		)
 	}
 
 	k.SetValidator(ctx, validator)
@@ -130,7 +158,7 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
 		tags.Moniker, []byte(description.Moniker),
 		tags.Identity, []byte(description.Identity),
-	)
+	).AppendTags(commissionTags).AppendTags(minSelfDelegationTags)
 
 	return sdk.Result{
 		Tags: tags,
@@ -204,3 +232,18 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 	)
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
+
+func handleMsgTransferDelegation(ctx sdk.Context, msg types.MsgTransferDelegation, k keeper.Keeper) sdk.Result {
+	_, err := k.TransferDelegation(ctx, msg.DelegatorAddr, msg.DelegatorDstAddr, msg.ValidatorAddr, msg.SharesAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstDelegator, []byte(msg.DelegatorDstAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+		tags.Shares, []byte(msg.SharesAmount.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
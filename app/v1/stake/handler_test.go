@@ -1048,3 +1048,215 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	validator, _ = keeper.GetValidator(ctx, valA)
 	require.Equal(t, validator.GetStatus(), sdk.Unbonding)
 }
+
+func TestCommissionAnnouncement(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, keeper := keep.CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	noticePeriod := keeper.CommissionNoticePeriod(ctx)
+
+	// create validator
+	commission := NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := types.NewMsgCreateValidator(validatorAddr, keep.PKs[0],
+		sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(100, 18)), Description{}, commission)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// announce a commission change
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(sdk.Day))
+	newRate := sdk.NewDecWithPrec(2, 1)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, &newRate), keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+	effectiveTime := ctx.BlockHeader().Time.Add(noticePeriod)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.Commission.Rate.Equal(sdk.NewDecWithPrec(1, 1)))
+	require.NotNil(t, validator.PendingCommission)
+	require.True(t, validator.PendingCommission.Rate.Equal(newRate))
+	require.True(t, validator.PendingCommission.EffectiveTime.Equal(effectiveTime))
+
+	// a new announcement replaces the previous one
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	newRate = sdk.NewDecWithPrec(15, 2)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, &newRate), keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+	require.Equal(t, 0, len(keeper.GetCommissionQueueTimeSlice(ctx, effectiveTime)))
+	effectiveTime = ctx.BlockHeader().Time.Add(noticePeriod)
+	require.Equal(t, 1, len(keeper.GetCommissionQueueTimeSlice(ctx, effectiveTime)))
+
+	// the commission is not changed before the effective time
+	ctx = ctx.WithBlockTime(effectiveTime.Add(-time.Second))
+	require.Empty(t, ApplyMatureCommissions(ctx, keeper))
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Commission.Rate.Equal(sdk.NewDecWithPrec(1, 1)))
+	require.NotNil(t, validator.PendingCommission)
+
+	// the commission is applied at the effective time
+	ctx = ctx.WithBlockTime(effectiveTime)
+	endBlockerTags := ApplyMatureCommissions(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Commission.Rate.Equal(newRate))
+	require.True(t, validator.Commission.UpdateTime.Equal(effectiveTime))
+	require.Nil(t, validator.PendingCommission)
+	require.Equal(t, 0, len(keeper.GetCommissionQueueTimeSlice(ctx, effectiveTime)))
+
+	require.Equal(t, []byte(TagAction), endBlockerTags[0].Key)
+	require.Equal(t, ActionApplyCommission, endBlockerTags[0].Value)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	validatorAddr2 := sdk.ValAddress(keep.Addrs[1])
+	delegatorAddr := keep.Addrs[2]
+	delegatorDstAddr := keep.Addrs[3]
+
+	// set the unbonding time
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = 1
+	keeper.SetParams(ctx, params)
+
+	// create the validators
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.NewIntWithDecimal(10, 18))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], sdk.NewIntWithDecimal(10, 18))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	EndBlocker(ctx, keeper)
+
+	// delegate
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, sdk.NewIntWithDecimal(10, 18))
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	tokensBefore := validator.Tokens
+
+	// cannot transfer to the same delegator
+	msgTransfer := NewMsgTransferDelegation(delegatorAddr, delegatorAddr, validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(4, 18)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.False(t, got.IsOK(), "expected an error, msg: %v", msgTransfer)
+
+	// cannot transfer more shares than delegated
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, delegatorDstAddr, validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(11, 18)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.False(t, got.IsOK(), "expected an error, msg: %v", msgTransfer)
+
+	// partial transfer
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, delegatorDstAddr, validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(4, 18)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.Equal(sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18))))
+	delegation, found = keeper.GetDelegation(ctx, delegatorDstAddr, validatorAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.Equal(sdk.NewDecFromInt(sdk.NewIntWithDecimal(4, 18))))
+
+	// the validator tokens are not changed
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Tokens.Equal(tokensBefore))
+
+	// full transfer removes the source delegation
+	msgTransfer = NewMsgTransferDelegation(delegatorAddr, delegatorDstAddr, validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	_, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	delegation, found = keeper.GetDelegation(ctx, delegatorDstAddr, validatorAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.Equal(sdk.NewDecFromInt(sdk.NewIntWithDecimal(10, 18))))
+
+	// redelegate to the second validator
+	msgBeginRedelegate := NewMsgBeginRedelegate(delegatorDstAddr, validatorAddr, validatorAddr2, sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18)))
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	// cannot transfer the delegation while the redelegation to the validator is in progress
+	msgTransfer = NewMsgTransferDelegation(delegatorDstAddr, delegatorAddr, validatorAddr2, sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18)))
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.False(t, got.IsOK(), "expected an error, msg: %v", msgTransfer)
+
+	// complete the redelegation
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(10 * time.Second))
+	EndBlocker(ctx, keeper)
+
+	// now the delegation can be transferred
+	got = handleMsgTransferDelegation(ctx, msgTransfer, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+}
+
+func TestMinSelfDelegation(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	validatorAddr2 := sdk.ValAddress(keep.Addrs[1])
+	setInstantUnbondPeriod(keeper, ctx)
+
+	// create the validators with a minimum self-delegation
+	msgCreateValidator := types.NewMsgCreateValidator(validatorAddr, keep.PKs[0],
+		sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(10, 18)), Description{}, commissionMsg).WithMinSelfDelegation(sdk.NewIntWithDecimal(5, 18))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	msgCreateValidator = types.NewMsgCreateValidator(validatorAddr2, keep.PKs[1],
+		sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(10, 18)), Description{}, commissionMsg).WithMinSelfDelegation(sdk.NewIntWithDecimal(5, 18))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.MinSelfDelegation.Equal(sdk.NewIntWithDecimal(5, 18)))
+
+	// the minimum self-delegation cannot be decreased
+	newMinSelfDelegation := sdk.NewIntWithDecimal(4, 18)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, nil).WithMinSelfDelegation(newMinSelfDelegation), keeper)
+	require.False(t, got.IsOK(), "expected edit-validator to fail")
+
+	// the minimum self-delegation cannot be raised above the self-delegation
+	newMinSelfDelegation = sdk.NewIntWithDecimal(11, 18)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, nil).WithMinSelfDelegation(newMinSelfDelegation), keeper)
+	require.False(t, got.IsOK(), "expected edit-validator to fail")
+
+	newMinSelfDelegation = sdk.NewIntWithDecimal(6, 18)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, nil).WithMinSelfDelegation(newMinSelfDelegation), keeper)
+	require.True(t, got.IsOK(), "expected edit-validator to be ok, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.MinSelfDelegation.Equal(newMinSelfDelegation))
+
+	// unbond while the self-delegation stays above the minimum
+	msgBeginUnbonding := NewMsgBeginUnbonding(sdk.AccAddress(validatorAddr), validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(3, 18)))
+	got = handleMsgBeginUnbonding(ctx, msgBeginUnbonding, keeper)
+	require.True(t, got.IsOK(), "expected begin-unbonding to be ok, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, validator.Jailed)
+	EndBlocker(ctx, keeper)
+
+	// the validator is jailed once the self-delegation drops below the minimum
+	msgBeginUnbonding = NewMsgBeginUnbonding(sdk.AccAddress(validatorAddr), validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(2, 18)))
+	got = handleMsgBeginUnbonding(ctx, msgBeginUnbonding, keeper)
+	require.True(t, got.IsOK(), "expected begin-unbonding to be ok, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Jailed)
+	require.Equal(t, types.JailReasonMinSelfDelegation, validator.JailReason)
+
+	// the jail reason is cleared once unjailed
+	keeper.Unjail(ctx, validator.ConsAddress())
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, validator.Jailed)
+	require.Equal(t, "", validator.JailReason)
+
+	// the redelegation of the operator is checked as well
+	msgBeginRedelegate := NewMsgBeginRedelegate(sdk.AccAddress(validatorAddr2), validatorAddr2, validatorAddr, sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18)))
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.True(t, got.IsOK(), "expected begin-redelegate to be ok, got %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr2)
+	require.True(t, validator.Jailed)
+	require.Equal(t, types.JailReasonMinSelfDelegation, validator.JailReason)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// AnnounceValidatorCommission validates a new commission rate and returns it as a pending commission
+// taking effect after the commission notice period. An earlier announcement of the validator is dropped.
+func (k Keeper) AnnounceValidatorCommission(ctx sdk.Context, validator types.Validator, newRate sdk.Dec) (types.PendingCommission, sdk.Error) {
+	blockTime := ctx.BlockHeader().Time
+
+	if err := validator.Commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return types.PendingCommission{}, err
+	}
+
+	if validator.PendingCommission != nil {
+		k.DeleteCommissionQueue(ctx, validator)
+	}
+
+	return types.NewPendingCommission(newRate, blockTime.Add(k.CommissionNoticePeriod(ctx))), nil
+}
+
+// gets a specific pending commission queue timeslice. A timeslice is a slice of validator addresses
+// whose announced commission takes effect at a certain time.
+func (k Keeper) GetCommissionQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCommissionQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ValAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &valAddrs)
+	return valAddrs
+}
+
+// Sets a specific pending commission queue timeslice.
+func (k Keeper) SetCommissionQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(GetCommissionQueueTimeKey(timestamp), bz)
+}
+
+// Deletes a specific pending commission queue timeslice.
+func (k Keeper) DeleteCommissionQueueTimeSlice(ctx sdk.Context, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCommissionQueueTimeKey(timestamp))
+}
+
+// Insert a validator address to the timeslice of its pending commission in the commission queue
+func (k Keeper) InsertCommissionQueue(ctx sdk.Context, val types.Validator) {
+	effectiveTime := val.PendingCommission.EffectiveTime
+	timeSlice := k.GetCommissionQueueTimeSlice(ctx, effectiveTime)
+	timeSlice = append(timeSlice, val.OperatorAddr)
+	k.SetCommissionQueueTimeSlice(ctx, effectiveTime, timeSlice)
+}
+
+// Delete a validator address from the timeslice of its pending commission in the commission queue
+func (k Keeper) DeleteCommissionQueue(ctx sdk.Context, val types.Validator) {
+	effectiveTime := val.PendingCommission.EffectiveTime
+	timeSlice := k.GetCommissionQueueTimeSlice(ctx, effectiveTime)
+	newTimeSlice := []sdk.ValAddress{}
+	for _, addr := range timeSlice {
+		if !bytes.Equal(addr, val.OperatorAddr) {
+			newTimeSlice = append(newTimeSlice, addr)
+		}
+	}
+	if len(newTimeSlice) == 0 {
+		k.DeleteCommissionQueueTimeSlice(ctx, effectiveTime)
+	} else {
+		k.SetCommissionQueueTimeSlice(ctx, effectiveTime, newTimeSlice)
+	}
+}
+
+// Returns all the pending commission queue timeslices from time 0 until endTime
+func (k Keeper) CommissionQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(CommissionQueueKey, sdk.InclusiveEndBytes(GetCommissionQueueTimeKey(endTime)))
+}
+
+// Applies all the pending commissions which have taken effect, and returns the updated validators
+func (k Keeper) ApplyAllMatureCommissionQueue(ctx sdk.Context) (validators []types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+	commissionTimesliceIterator := k.CommissionQueueIterator(ctx, blockTime)
+	defer commissionTimesliceIterator.Close()
+	for ; commissionTimesliceIterator.Valid(); commissionTimesliceIterator.Next() {
+		timeslice := []sdk.ValAddress{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(commissionTimesliceIterator.Value(), &timeslice)
+		for _, valAddr := range timeslice {
+			validator, found := k.GetValidator(ctx, valAddr)
+			if !found || validator.PendingCommission == nil {
+				continue
+			}
+			// the rewards are settled with the old rate before the commission changes
+			k.OnValidatorModified(ctx, valAddr)
+
+			validator.Commission.Rate = validator.PendingCommission.Rate
+			validator.Commission.UpdateTime = blockTime
+			validator.PendingCommission = nil
+			k.SetValidator(ctx, validator)
+			validators = append(validators, validator)
+		}
+		store.Delete(commissionTimesliceIterator.Key())
+	}
+	return validators
+}
@@ -456,16 +456,14 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	// subtract shares from delegator
 	delegation.Shares = delegation.Shares.Sub(shares)
 
+	// if the delegation is the operator of the validator and its self-delegation
+	// is removed or drops below the minimum then trigger a jail validator
+	if k.jailIfBelowMinSelfDelegation(ctx, validator, delegation) {
+		validator = k.mustGetValidator(ctx, validator.OperatorAddr)
+	}
+
 	// remove the delegation
 	if delegation.Shares.IsZero() {
-
-		// if the delegation is the operator of the validator then
-		// trigger a jail validator
-		if bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) && !validator.Jailed {
-			k.jailValidator(ctx, validator)
-			validator = k.mustGetValidator(ctx, validator.OperatorAddr)
-		}
-
 		k.RemoveDelegation(ctx, delegation)
 	} else {
 		// Update height
@@ -486,6 +484,20 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	return amount, nil
 }
 
+// jail the validator if the given delegation is the self-delegation of its operator and it
+// is removed or holds less tokens than the minimum self-delegation, return true if jailed
+func (k Keeper) jailIfBelowMinSelfDelegation(ctx sdk.Context, validator types.Validator, delegation types.Delegation) bool {
+	if !bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) || validator.Jailed {
+		return false
+	}
+	if !delegation.Shares.IsZero() && !validator.SelfDelegationBelowMinimum(delegation.Shares) {
+		return false
+	}
+	validator.JailReason = types.JailReasonMinSelfDelegation
+	k.jailValidator(ctx, validator)
+	return true
+}
+
 // begin unbonding an unbonding record
 func (k Keeper) BeginUnbonding(ctx sdk.Context,
 	delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.UnbondingDelegation, sdk.Error) {
@@ -618,3 +630,72 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	k.RemoveRedelegation(ctx, red)
 	return nil
 }
+
+// transfer shares of a delegation to another delegator of the same validator
+func (k Keeper) TransferDelegation(ctx sdk.Context, delSrcAddr, delDstAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.Delegation, sdk.Error) {
+
+	if bytes.Equal(delSrcAddr, delDstAddr) {
+		return types.Delegation{}, types.ErrSelfDelegationTransfer(k.Codespace())
+	}
+
+	// the shares received by an ongoing redelegation stay with the delegator, as they
+	// are slashed from its delegation for the infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delSrcAddr, valAddr) {
+		return types.Delegation{}, types.ErrTransferReceivingRedelegation(k.Codespace())
+	}
+
+	srcDelegation, found := k.GetDelegation(ctx, delSrcAddr, valAddr)
+	if !found {
+		return types.Delegation{}, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+
+	if srcDelegation.Shares.LT(sharesAmount) {
+		return types.Delegation{}, types.ErrNotEnoughDelegationShares(k.Codespace(), srcDelegation.Shares.QuoInt(sdk.NewIntWithDecimal(1, 18)).RoundInt().String())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.Delegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	if validator.Jailed && !bytes.Equal(validator.OperatorAddr, delDstAddr) {
+		return types.Delegation{}, types.ErrValidatorJailed(k.Codespace())
+	}
+
+	// withdraw the rewards of both delegations before the shares are moved
+	k.OnDelegationSharesModified(ctx, delSrcAddr, valAddr)
+
+	dstDelegation, found := k.GetDelegation(ctx, delDstAddr, valAddr)
+	if found {
+		k.OnDelegationSharesModified(ctx, delDstAddr, valAddr)
+	} else {
+		dstDelegation = types.Delegation{
+			DelegatorAddr: delDstAddr,
+			ValidatorAddr: valAddr,
+			Shares:        sdk.ZeroDec(),
+		}
+		k.OnDelegationCreated(ctx, delDstAddr, valAddr)
+	}
+
+	// subtract shares from the source delegator
+	srcDelegation.Shares = srcDelegation.Shares.Sub(sharesAmount)
+	k.jailIfBelowMinSelfDelegation(ctx, validator, srcDelegation)
+	if srcDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, srcDelegation)
+	} else {
+		srcDelegation.Height = ctx.BlockHeight()
+		k.SetDelegation(ctx, srcDelegation)
+	}
+
+	// add shares to the destination delegator
+	dstDelegation.Shares = dstDelegation.Shares.Add(sharesAmount)
+	dstDelegation.Height = ctx.BlockHeight()
+	k.SetDelegation(ctx, dstDelegation)
+
+	ctx.Logger().Info("Transfer delegation", "validator_address", valAddr.String(),
+		"src_delegator_address", delSrcAddr.String(), "dst_delegator_address", delDstAddr.String(),
+		"shares", sharesAmount.String())
+
+	return dstDelegation, nil
+}
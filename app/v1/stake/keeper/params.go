@@ -25,10 +25,19 @@ func (k Keeper) MaxValidators(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// CommissionNoticePeriod - Minimum time between the announcement and the application of a commission change
+func (k Keeper) CommissionNoticePeriod(ctx sdk.Context) (res time.Duration) {
+	// the parameter is not stored on the chains started before it was added
+	res = types.DefaultParams().CommissionNoticePeriod
+	k.paramstore.GetIfExists(ctx, types.KeyCommissionNoticePeriod, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.UnbondingTime = k.UnbondingTime(ctx)
 	res.MaxValidators = k.MaxValidators(ctx)
+	res.CommissionNoticePeriod = k.CommissionNoticePeriod(ctx)
 	return
 }
 
@@ -34,7 +34,32 @@ const (
 //    Infraction committed at the current height or at a past height,
 //    not at a height in the future
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) (tags sdk.Tags) {
+	tags, slashedTokens := k.slash(ctx, consAddr, infractionHeight, power, slashFactor)
+	k.burnSlashedTokens(ctx, slashedTokens)
+	return
+}
+
+// Slash a validator like Slash, but pay the rewardFraction of the slashed tokens
+// to the recipient and only burn the remainder
+func (k Keeper) SlashAndReward(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec,
+	rewardFraction sdk.Dec, recipient sdk.AccAddress) (tags sdk.Tags, reward sdk.Int, err sdk.Error) {
+	tags, slashedTokens := k.slash(ctx, consAddr, infractionHeight, power, slashFactor)
+	reward = sdk.NewDecFromInt(slashedTokens).Mul(rewardFraction).TruncateInt()
+	k.burnSlashedTokens(ctx, slashedTokens.Sub(reward))
+
+	// The slashed tokens are still loosen tokens, so the reward is paid without increasing the loosen tokens
+	if reward.IsPositive() {
+		if _, _, err := k.bankKeeper.AddCoins(ctx, recipient, sdk.Coins{sdk.NewCoin(types.StakeDenom, reward)}); err != nil {
+			return nil, sdk.ZeroInt(), err
+		}
+	}
+	return tags, reward, nil
+}
+
+// slash a validator without burning the slashed tokens, return the amount of the slashed tokens
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) (tags sdk.Tags, slashedTokens sdk.Int) {
 	logger := ctx.Logger()
+	slashedTokens = sdk.ZeroInt()
 
 	if slashFactor.LT(sdk.ZeroDec()) {
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
@@ -90,8 +115,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through unbonding delegations from slashed validator
 		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, operatorAddress)
 		for _, unbondingDelegation := range unbondingDelegations {
-			amountSlashed, slashUnbondingTags := k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			amountSlashed, unbondingSlashedTokens, slashUnbondingTags := k.slashUnbondingDelegationTokens(ctx, unbondingDelegation, infractionHeight, slashFactor)
 			tags = tags.AppendTags(slashUnbondingTags)
+			slashedTokens = slashedTokens.Add(unbondingSlashedTokens)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -101,8 +127,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through redelegations from slashed validator
 		redelegations := k.GetRedelegationsFromValidator(ctx, operatorAddress)
 		for _, redelegation := range redelegations {
-			amountSlashed, slashRedelegationTags := k.slashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			amountSlashed, redelegationSlashedTokens, slashRedelegationTags := k.slashRedelegationTokens(ctx, validator, redelegation, infractionHeight, slashFactor)
 			tags = tags.AppendTags(slashRedelegationTags)
+			slashedTokens = slashedTokens.Add(redelegationSlashedTokens)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -120,7 +147,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	if !tokensToBurn.Sub(tokensToBurn.TruncateDec()).IsZero() {
 		panic("slash decimal token in redelegation")
 	}
-	k.bankKeeper.DecreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, tokensToBurn.TruncateInt())})
+	slashedTokens = slashedTokens.Add(tokensToBurn.TruncateInt())
 	slashToken, err := strconv.ParseFloat(tokensToBurn.QuoInt(sdk.AttoScaleFactor).String(), 64)
 	if err == nil {
 		k.metrics.SetSlashedToken(validator.GetOperator().String(), slashToken)
@@ -132,6 +159,11 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	return
 }
 
+// burn the slashed tokens, which have been returned to the loosen tokens
+func (k Keeper) burnSlashedTokens(ctx sdk.Context, slashedTokens sdk.Int) {
+	k.bankKeeper.DecreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, slashedTokens)})
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
@@ -156,18 +188,28 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 // insufficient stake remaining)
 func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
 	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec, tags sdk.Tags) {
+	slashAmount, slashedTokens, tags := k.slashUnbondingDelegationTokens(ctx, unbondingDelegation, infractionHeight, slashFactor)
+	k.burnSlashedTokens(ctx, slashedTokens)
+	return
+}
+
+// slash an unbonding delegation without burning the slashed tokens,
+// additionally return the amount of the tokens actually slashed
+func (k Keeper) slashUnbondingDelegationTokens(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec, slashedTokens sdk.Int, tags sdk.Tags) {
+	slashedTokens = sdk.ZeroInt()
 
 	now := ctx.BlockHeader().Time
 
 	// If unbonding started before this height, stake didn't contribute to infraction
 	if unbondingDelegation.CreationHeight < infractionHeight {
-		return sdk.ZeroDec(), nil
+		return sdk.ZeroDec(), slashedTokens, nil
 	}
 
 	if unbondingDelegation.MinTime.Before(now) {
 		// Unbonding delegation no longer eligible for slashing, skip it
 		// TODO Settle and delete it automatically?
-		return sdk.ZeroDec(), nil
+		return sdk.ZeroDec(), slashedTokens, nil
 	}
 
 	// Calculate slash amount proportional to stake contributing to infraction
@@ -184,7 +226,7 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		unbondingDelegation.Balance.Amount = unbondingDelegation.Balance.Amount.Sub(unbondingSlashAmount)
 		tags = tags.AppendTag(fmt.Sprintf(SlashUnbondindDelegation, unbondingDelegation.DelegatorAddr, unbondingDelegation.ValidatorAddr), []byte(unbondingSlashAmount.String()))
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
-		k.bankKeeper.DecreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, unbondingSlashAmount)})
+		slashedTokens = unbondingSlashAmount
 	}
 
 	return
@@ -198,18 +240,29 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 // nolint: unparam
 func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, redelegation types.Redelegation,
 	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec, tags sdk.Tags) {
+	slashAmount, slashedTokens, tags := k.slashRedelegationTokens(ctx, validator, redelegation, infractionHeight, slashFactor)
+	k.burnSlashedTokens(ctx, slashedTokens)
+	return
+}
+
+// slash a redelegation without burning the slashed tokens,
+// additionally return the amount of the tokens actually slashed
+// nolint: unparam
+func (k Keeper) slashRedelegationTokens(ctx sdk.Context, validator types.Validator, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec, slashedTokens sdk.Int, tags sdk.Tags) {
+	slashedTokens = sdk.ZeroInt()
 
 	now := ctx.BlockHeader().Time
 
 	// If redelegation started before this height, stake didn't contribute to infraction
 	if redelegation.CreationHeight < infractionHeight {
-		return sdk.ZeroDec(), nil
+		return sdk.ZeroDec(), slashedTokens, nil
 	}
 
 	if redelegation.MinTime.Before(now) {
 		// Redelegation no longer eligible for slashing, skip it
 		// TODO Delete it automatically?
-		return sdk.ZeroDec(), nil
+		return sdk.ZeroDec(), slashedTokens, nil
 	}
 
 	// Calculate slash amount proportional to stake contributing to infraction
@@ -234,7 +287,7 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		delegation, found := k.GetDelegation(ctx, redelegation.DelegatorAddr, redelegation.ValidatorDstAddr)
 		if !found {
 			// If deleted, delegation has zero shares, and we can't unbond any more
-			return slashAmount, nil
+			return slashAmount, slashedTokens, nil
 		}
 		if sharesToUnbond.GT(delegation.Shares) {
 			sharesToUnbond = delegation.Shares
@@ -245,7 +298,7 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
 		tags = tags.AppendTag(fmt.Sprintf(SlashValidatorRedelegation, redelegation.ValidatorDstAddr, redelegation.ValidatorSrcAddr, redelegation.DelegatorAddr), []byte(tokensToBurn.String()))
-		k.bankKeeper.DecreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, tokensToBurn.TruncateInt())})
+		slashedTokens = tokensToBurn.TruncateInt()
 	}
 
 	return
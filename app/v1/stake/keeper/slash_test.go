@@ -246,6 +246,31 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18)), oldPool.BondedPool.BondedTokens.Sub(newPool.BondedPool.BondedTokens))
 }
 
+// tests SlashAndReward at the current height
+func TestSlashAndReward(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, sdk.NewIntWithDecimal(10, 18))
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	rewardFraction := sdk.NewDecWithPrec(1, 1)
+	recipient := sdk.AccAddress(addrVals[1])
+
+	oldLoosenTokens := keeper.bankKeeper.GetLoosenCoins(ctx).AmountOf(types.StakeDenom)
+	oldCoins := keeper.bankKeeper.GetCoins(ctx, recipient).AmountOf(types.StakeDenom)
+	_, reward, err := keeper.SlashAndReward(ctx, consAddr, ctx.BlockHeight(), 10, fraction, rewardFraction, recipient)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewIntWithDecimal(5, 17), reward)
+
+	// the validator is slashed
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18)), validator.GetTokens())
+
+	// the recipient is rewarded from the slashed tokens and only the remainder is burned
+	require.Equal(t, oldCoins.Add(reward), keeper.bankKeeper.GetCoins(ctx, recipient).AmountOf(types.StakeDenom))
+	require.Equal(t, oldLoosenTokens.Add(sdk.NewIntWithDecimal(5, 18)).Sub(sdk.NewIntWithDecimal(5, 18).Sub(reward)),
+		keeper.bankKeeper.GetLoosenCoins(ctx).AmountOf(types.StakeDenom))
+}
+
 // tests Slash at a previous height with an unbonding delegation
 func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ctx, keeper, _ := setupHelper(t, sdk.NewIntWithDecimal(10, 18))
@@ -195,6 +195,7 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 
 	pool := k.GetPool(ctx)
 	validator.Jailed = false
+	validator.JailReason = ""
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator, pool)
 }
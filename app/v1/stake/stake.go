@@ -13,6 +13,7 @@ type (
 	Validator               = types.Validator
 	Description             = types.Description
 	Commission              = types.Commission
+	PendingCommission       = types.PendingCommission
 	Delegation              = types.Delegation
 	UnbondingDelegation     = types.UnbondingDelegation
 	Redelegation            = types.Redelegation
@@ -25,6 +26,7 @@ type (
 	MsgDelegate             = types.MsgDelegate
 	MsgBeginUnbonding       = types.MsgBeginUnbonding
 	MsgBeginRedelegate      = types.MsgBeginRedelegate
+	MsgTransferDelegation   = types.MsgTransferDelegation
 	GenesisState            = types.GenesisState
 	QueryDelegatorParams    = querier.QueryDelegatorParams
 	QueryValidatorParams    = querier.QueryValidatorParams
@@ -78,6 +80,7 @@ var (
 	NewCommission         = types.NewCommission
 	NewCommissionMsg      = types.NewCommissionMsg
 	NewCommissionWithTime = types.NewCommissionWithTime
+	NewPendingCommission  = types.NewPendingCommission
 	NewGenesisState       = types.NewGenesisState
 	DefaultGenesisState   = types.DefaultGenesisState
 	RegisterCodec         = types.RegisterCodec
@@ -88,6 +91,7 @@ var (
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgBeginUnbonding            = types.NewMsgBeginUnbonding
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
+	NewMsgTransferDelegation        = types.NewMsgTransferDelegation
 
 	NewQuerier              = querier.NewQuerier
 	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
@@ -135,6 +139,9 @@ var (
 	ErrDescriptionLength              = types.ErrDescriptionLength
 	ErrCommissionNegative             = types.ErrCommissionNegative
 	ErrCommissionHuge                 = types.ErrCommissionHuge
+	ErrMinSelfDelegationInvalid       = types.ErrMinSelfDelegationInvalid
+	ErrMinSelfDelegationDecreased     = types.ErrMinSelfDelegationDecreased
+	ErrSelfDelegationBelowMinimum     = types.ErrSelfDelegationBelowMinimum
 
 	ErrNilDelegatorAddr          = types.ErrNilDelegatorAddr
 	ErrBadDenom                  = types.ErrBadDenom
@@ -166,6 +173,8 @@ var (
 	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
 	ActionBeginRedelegation    = tags.ActionBeginRedelegation
 	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
+	ActionApplyCommission      = tags.ActionApplyCommission
+	ActionTransferDelegation   = tags.ActionTransferDelegation
 
 	TagAction       = tags.Action
 	TagSrcValidator = tags.SrcValidator
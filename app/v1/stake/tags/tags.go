@@ -13,15 +13,23 @@ var (
 	ActionCompleteUnbonding    = []byte("complete-unbonding")
 	ActionBeginRedelegation    = []byte("begin-redelegation")
 	ActionCompleteRedelegation = []byte("complete-redelegation")
+	ActionApplyCommission      = []byte("apply-commission")
+	ActionTransferDelegation   = []byte("transfer-delegation")
 
 	Action       = sdk.TagAction
 	SrcValidator = sdk.TagSrcValidator
 	DstValidator = sdk.TagDstValidator
 	Delegator    = sdk.TagDelegator
+	DstDelegator = "dst-delegator"
 	Moniker      = "moniker"
 	Identity     = "identity"
 	EndTime      = "end-time"
 	Balance      = "balance"
 	SharesSrc    = "shares-src"
 	SharesDst    = "shares-dst"
+	Shares       = "shares"
+
+	CommissionRate    = "commission-rate"
+	EffectiveTime     = "effective-time"
+	MinSelfDelegation = "min-self-delegation"
 )
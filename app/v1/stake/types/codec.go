@@ -11,6 +11,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDelegate{}, "irishub/stake/MsgDelegate", nil)
 	cdc.RegisterConcrete(MsgBeginUnbonding{}, "irishub/stake/BeginUnbonding", nil)
 	cdc.RegisterConcrete(MsgBeginRedelegate{}, "irishub/stake/BeginRedelegate", nil)
+	cdc.RegisterConcrete(MsgTransferDelegation{}, "irishub/stake/TransferDelegation", nil)
 
 	cdc.RegisterConcrete(Pool{}, "irishub/stake/Pool", nil)
 	cdc.RegisterConcrete(BondedPool{}, "irishub/stake/BondedPool", nil)
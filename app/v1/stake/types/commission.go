@@ -16,6 +16,12 @@ type (
 		UpdateTime    time.Time `json:"update_time"`     // the last time the commission rate was changed
 	}
 
+	// PendingCommission defines a commission rate change announced by the validator in advance.
+	PendingCommission struct {
+		Rate          sdk.Dec   `json:"rate"`           // the announced commission rate
+		EffectiveTime time.Time `json:"effective_time"` // the time from which the announced rate is charged
+	}
+
 	// CommissionMsg defines a commission message to be used for creating a
 	// validator.
 	CommissionMsg struct {
@@ -71,6 +77,19 @@ func (c Commission) String() string {
 	)
 }
 
+// NewPendingCommission returns an initialized pending commission.
+func NewPendingCommission(rate sdk.Dec, effectiveTime time.Time) PendingCommission {
+	return PendingCommission{
+		Rate:          rate,
+		EffectiveTime: effectiveTime,
+	}
+}
+
+// String implements the Stringer interface for a PendingCommission.
+func (pc PendingCommission) String() string {
+	return fmt.Sprintf("rate: %s, effectiveTime: %v", pc.Rate.String(), pc.EffectiveTime)
+}
+
 // Validate performs basic sanity validation checks of initial commission
 // parameters. If validation fails, an SDK error is returned.
 func (c Commission) Validate() sdk.Error {
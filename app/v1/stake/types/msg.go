@@ -11,18 +11,19 @@ import (
 const MsgRoute = "stake"
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}
+var _, _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}, &MsgTransferDelegation{}
 
 //______________________________________________________________________
 
 // MsgCreateValidator - struct for bonding transactions
 type MsgCreateValidator struct {
 	Description
-	Commission    CommissionMsg
-	DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-	ValidatorAddr sdk.ValAddress `json:"validator_address"`
-	PubKey        crypto.PubKey  `json:"pubkey"`
-	Delegation    sdk.Coin       `json:"delegation"`
+	Commission        CommissionMsg
+	DelegatorAddr     sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddr     sdk.ValAddress `json:"validator_address"`
+	PubKey            crypto.PubKey  `json:"pubkey"`
+	Delegation        sdk.Coin       `json:"delegation"`
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
@@ -47,6 +48,12 @@ func NewMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.ValAddr
 	}
 }
 
+// WithMinSelfDelegation sets the optional minimum self-delegation of the validator
+func (msg MsgCreateValidator) WithMinSelfDelegation(minSelfDelegation sdk.Int) MsgCreateValidator {
+	msg.MinSelfDelegation = minSelfDelegation
+	return msg
+}
+
 //nolint
 func (msg MsgCreateValidator) Route() string { return MsgRoute }
 func (msg MsgCreateValidator) Type() string  { return "create_validator" }
@@ -66,19 +73,25 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 
 // get the bytes for the message signer to sign on
 func (msg MsgCreateValidator) GetSignBytes() []byte {
-	b, err := MsgCdc.MarshalJSON(struct {
+	signMsg := struct {
 		Description
-		Commission    CommissionMsg
-		DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-		ValidatorAddr sdk.ValAddress `json:"validator_address"`
-		PubKey        string         `json:"pubkey"`
-		Delegation    sdk.Coin       `json:"delegation"`
+		Commission        CommissionMsg
+		DelegatorAddr     sdk.AccAddress `json:"delegator_address"`
+		ValidatorAddr     sdk.ValAddress `json:"validator_address"`
+		PubKey            string         `json:"pubkey"`
+		Delegation        sdk.Coin       `json:"delegation"`
+		MinSelfDelegation string         `json:"min_self_delegation,omitempty"`
 	}{
 		Description:   msg.Description,
 		ValidatorAddr: msg.ValidatorAddr,
 		PubKey:        sdk.MustBech32ifyConsPub(msg.PubKey),
 		Delegation:    msg.Delegation,
-	})
+	}
+	// omitted if not given, to keep the sign bytes of the msgs sent before it was added
+	if msg.MinSelfDelegation != (sdk.Int{}) {
+		signMsg.MinSelfDelegation = msg.MinSelfDelegation.String()
+	}
+	b, err := MsgCdc.MarshalJSON(signMsg)
 	if err != nil {
 		panic(err)
 	}
@@ -102,6 +115,15 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.Commission == (CommissionMsg{}) {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "commission must be included")
 	}
+	// the minimum self-delegation is optional, as the msgs sent before it was added have none
+	if msg.MinSelfDelegation != (sdk.Int{}) {
+		if msg.MinSelfDelegation.IsNegative() {
+			return ErrMinSelfDelegationInvalid(DefaultCodespace)
+		}
+		if msg.Delegation.Amount.LT(msg.MinSelfDelegation) {
+			return ErrSelfDelegationBelowMinimum(DefaultCodespace)
+		}
+	}
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return err
 	}
@@ -121,6 +143,9 @@ type MsgEditValidator struct {
 	//
 	// REF: #2373
 	CommissionRate *sdk.Dec `json:"commission_rate"`
+
+	// the minimum self-delegation can only be raised, it is not updated if nil
+	MinSelfDelegation *sdk.Int `json:"min_self_delegation"`
 }
 
 func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec) MsgEditValidator {
@@ -131,6 +156,12 @@ func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRat
 	}
 }
 
+// WithMinSelfDelegation sets the new minimum self-delegation of the validator
+func (msg MsgEditValidator) WithMinSelfDelegation(newMinSelfDelegation sdk.Int) MsgEditValidator {
+	msg.MinSelfDelegation = &newMinSelfDelegation
+	return msg
+}
+
 //nolint
 func (msg MsgEditValidator) Route() string { return MsgRoute }
 func (msg MsgEditValidator) Type() string  { return "edit_validator" }
@@ -142,10 +173,12 @@ func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgEditValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		ValidatorAddr sdk.ValAddress `json:"address"`
+		ValidatorAddr     sdk.ValAddress `json:"address"`
+		MinSelfDelegation *sdk.Int       `json:"min_self_delegation,omitempty"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -166,6 +199,10 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return err
 	}
+
+	if msg.MinSelfDelegation != nil && (*msg.MinSelfDelegation == (sdk.Int{}) || !msg.MinSelfDelegation.GT(sdk.ZeroInt())) {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
 	return nil
 }
 
@@ -332,3 +369,63 @@ func (msg MsgBeginUnbonding) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgTransferDelegation - struct for transferring delegation shares to another delegator
+type MsgTransferDelegation struct {
+	DelegatorAddr    sdk.AccAddress `json:"delegator_addr"`
+	DelegatorDstAddr sdk.AccAddress `json:"delegator_dst_addr"`
+	ValidatorAddr    sdk.ValAddress `json:"validator_addr"`
+	SharesAmount     sdk.Dec        `json:"shares_amount"`
+}
+
+func NewMsgTransferDelegation(delAddr, delDstAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) MsgTransferDelegation {
+	return MsgTransferDelegation{
+		DelegatorAddr:    delAddr,
+		DelegatorDstAddr: delDstAddr,
+		ValidatorAddr:    valAddr,
+		SharesAmount:     sharesAmount,
+	}
+}
+
+//nolint
+func (msg MsgTransferDelegation) Route() string                { return MsgRoute }
+func (msg MsgTransferDelegation) Type() string                 { return "transfer_delegation" }
+func (msg MsgTransferDelegation) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddr} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTransferDelegation) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		DelegatorAddr    sdk.AccAddress `json:"delegator_addr"`
+		DelegatorDstAddr sdk.AccAddress `json:"delegator_dst_addr"`
+		ValidatorAddr    sdk.ValAddress `json:"validator_addr"`
+		SharesAmount     string         `json:"shares_amount"`
+	}{
+		DelegatorAddr:    msg.DelegatorAddr,
+		DelegatorDstAddr: msg.DelegatorDstAddr,
+		ValidatorAddr:    msg.ValidatorAddr,
+		SharesAmount:     msg.SharesAmount.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgTransferDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil || msg.DelegatorDstAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddr.Equals(msg.DelegatorDstAddr) {
+		return ErrSelfDelegationTransfer(DefaultCodespace)
+	}
+	if msg.SharesAmount.Int == nil || msg.SharesAmount.LTE(sdk.ZeroDec()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
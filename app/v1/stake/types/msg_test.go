@@ -72,6 +72,60 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for the minimum self-delegation of MsgCreateValidator
+func TestMsgCreateValidatorMinSelfDelegation(t *testing.T) {
+	commission := NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	description := NewDescription("a", "b", "c", "d")
+
+	tests := []struct {
+		name              string
+		minSelfDelegation sdk.Int
+		expectPass        bool
+	}{
+		{"not given", sdk.Int{}, true},
+		{"zero", sdk.ZeroInt(), true},
+		{"positive", sdk.OneInt(), true},
+		{"equal to the self delegation", coinPos.Amount, true},
+		{"negative", sdk.NewInt(-1), false},
+		{"above the self delegation", coinPos.Amount.AddRaw(1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCreateValidator(addr1, pk1, coinPos, description, commission).WithMinSelfDelegation(tc.minSelfDelegation)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// the sign bytes of the msgs without the minimum self-delegation are unchanged
+func TestMsgCreateValidatorSignBytes(t *testing.T) {
+	description := NewDescription("a", "b", "c", "d")
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, description, CommissionMsg{})
+	require.NotContains(t, string(msg.GetSignBytes()), "min_self_delegation")
+
+	msg = msg.WithMinSelfDelegation(sdk.OneInt())
+	require.Contains(t, string(msg.GetSignBytes()), `"min_self_delegation":"1"`)
+}
+
+// test ValidateBasic for the minimum self-delegation of MsgEditValidator
+func TestMsgEditValidatorMinSelfDelegation(t *testing.T) {
+	description := NewDescription("a", "b", "c", "d")
+
+	msg := NewMsgEditValidator(addr1, description, nil)
+	require.Nil(t, msg.ValidateBasic())
+	require.NotContains(t, string(msg.GetSignBytes()), "min_self_delegation")
+
+	msg = msg.WithMinSelfDelegation(sdk.OneInt())
+	require.Nil(t, msg.ValidateBasic())
+	require.Contains(t, string(msg.GetSignBytes()), `"min_self_delegation":"1"`)
+
+	msg = msg.WithMinSelfDelegation(sdk.ZeroInt())
+	require.NotNil(t, msg.ValidateBasic())
+}
+
 // test ValidateBasic and GetSigners for MsgCreateValidatorOnBehalfOf
 func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	commission1 := NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
@@ -196,3 +250,32 @@ func TestMsgBeginUnbonding(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTransferDelegation
+func TestMsgTransferDelegation(t *testing.T) {
+	tests := []struct {
+		name             string
+		delegatorAddr    sdk.AccAddress
+		delegatorDstAddr sdk.AccAddress
+		validatorAddr    sdk.ValAddress
+		sharesAmount     sdk.Dec
+		expectPass       bool
+	}{
+		{"regular", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(1, 1), true},
+		{"negative decimal", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(-1, 1), false},
+		{"zero amount", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.ZeroDec(), false},
+		{"same delegator", sdk.AccAddress(addr1), sdk.AccAddress(addr1), addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty destination delegator", sdk.AccAddress(addr1), sdk.AccAddress(emptyAddr), addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty validator", sdk.AccAddress(addr1), sdk.AccAddress(addr2), emptyAddr, sdk.NewDecWithPrec(1, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferDelegation(tc.delegatorAddr, tc.delegatorDstAddr, tc.validatorAddr, tc.sharesAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -29,23 +29,26 @@ const (
 
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
+	KeyUnbondingTime          = []byte("UnbondingTime")
+	KeyMaxValidators          = []byte("MaxValidators")
+	KeyCommissionNoticePeriod = []byte("CommissionNoticePeriod")
 )
 
 var _ params.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for staking
 type Params struct {
-	UnbondingTime time.Duration `json:"unbonding_time"`
-	MaxValidators uint16        `json:"max_validators"` // maximum number of validators
+	UnbondingTime          time.Duration `json:"unbonding_time"`
+	MaxValidators          uint16        `json:"max_validators"`           // maximum number of validators
+	CommissionNoticePeriod time.Duration `json:"commission_notice_period"` // minimum time between the announcement and the application of a commission change
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf(`Stake Params:
-  stake/UnbondingTime:  %s
-  stake/MaxValidators:  %d`,
-		p.UnbondingTime, p.MaxValidators)
+  stake/UnbondingTime:           %s
+  stake/MaxValidators:           %d
+  stake/CommissionNoticePeriod:  %s`,
+		p.UnbondingTime, p.MaxValidators, p.CommissionNoticePeriod)
 }
 
 // Implements params.Params
@@ -53,6 +56,7 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 	return params.KeyValuePairs{
 		{KeyUnbondingTime, &p.UnbondingTime},
 		{KeyMaxValidators, &p.MaxValidators},
+		{KeyCommissionNoticePeriod, &p.CommissionNoticePeriod},
 	}
 }
 
@@ -76,6 +80,15 @@ func (p *Params) Validate(key string, value string) (interface{}, sdk.Error) {
 			return nil, err
 		}
 		return uint16(maxValidators), nil
+	case string(KeyCommissionNoticePeriod):
+		commissionNoticePeriod, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateCommissionNoticePeriod(commissionNoticePeriod); err != nil {
+			return nil, err
+		}
+		return commissionNoticePeriod, nil
 	default:
 		return nil, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf("%s is not found", key))
 	}
@@ -93,6 +106,9 @@ func (p *Params) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (st
 	case string(KeyMaxValidators):
 		err := cdc.UnmarshalJSON(bytes, &p.MaxValidators)
 		return strconv.Itoa(int(p.MaxValidators)), err
+	case string(KeyCommissionNoticePeriod):
+		err := cdc.UnmarshalJSON(bytes, &p.CommissionNoticePeriod)
+		return p.CommissionNoticePeriod.String(), err
 	default:
 		return "", fmt.Errorf("%s is not existed", key)
 	}
@@ -112,8 +128,9 @@ func (p Params) Equal(p2 Params) bool {
 // default stake module params
 func DefaultParams() Params {
 	return Params{
-		UnbondingTime: 3 * sdk.Week,
-		MaxValidators: 100,
+		UnbondingTime:          3 * sdk.Week,
+		MaxValidators:          100,
+		CommissionNoticePeriod: sdk.ThreeDays,
 	}
 }
 
@@ -124,6 +141,9 @@ func ValidateParams(p Params) error {
 	if err := validateMaxValidators(p.MaxValidators); err != nil {
 		return err
 	}
+	if err := validateCommissionNoticePeriod(p.CommissionNoticePeriod); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -133,6 +153,7 @@ func (p Params) HumanReadableString() string {
 	resp := "Params \n"
 	resp += fmt.Sprintf("Unbonding Time: %s\n", p.UnbondingTime)
 	resp += fmt.Sprintf("Max Validators: %d: \n", p.MaxValidators)
+	resp += fmt.Sprintf("Commission Notice Period: %s\n", p.CommissionNoticePeriod)
 	return resp
 }
 
@@ -159,3 +180,14 @@ func validateMaxValidators(v uint16) sdk.Error {
 	}
 	return nil
 }
+
+func validateCommissionNoticePeriod(v time.Duration) sdk.Error {
+	if sdk.NetworkType == sdk.Mainnet {
+		if v < sdk.Day || v > 2*sdk.Week {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidCommissionNoticePeriod, fmt.Sprintf("Invalid CommissionNoticePeriod [%s] should be between [1 day, 2 weeks]", v.String()))
+		}
+	} else if v < 0 || v > 2*sdk.Week {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidCommissionNoticePeriod, fmt.Sprintf("Invalid CommissionNoticePeriod [%s] should be between [0, 2 weeks]", v.String()))
+	}
+	return nil
+}
@@ -35,53 +35,64 @@ type Validator struct {
 	UnbondingHeight  int64     `json:"unbonding_height"` // if unbonding, height at which this validator has begun unbonding
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
-	Commission Commission `json:"commission"` // commission parameters
+	Commission        Commission         `json:"commission"`         // commission parameters
+	PendingCommission *PendingCommission `json:"pending_commission"` // commission change announced and not applied yet
+
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"` // minimum tokens of the operator's self-delegation
+	JailReason        string  `json:"jail_reason"`         // reason of the jailing by the stake module, if any
 }
 
 // NewValidator - initialize a new validator
 func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Description) Validator {
 	return Validator{
-		OperatorAddr:     operator,
-		ConsPubKey:       pubKey,
-		Jailed:           false,
-		Status:           sdk.Unbonded,
-		Tokens:           sdk.ZeroDec(),
-		DelegatorShares:  sdk.ZeroDec(),
-		Description:      description,
-		BondHeight:       int64(0),
-		UnbondingHeight:  int64(0),
-		UnbondingMinTime: time.Unix(0, 0).UTC(),
-		Commission:       NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		OperatorAddr:      operator,
+		ConsPubKey:        pubKey,
+		Jailed:            false,
+		Status:            sdk.Unbonded,
+		Tokens:            sdk.ZeroDec(),
+		DelegatorShares:   sdk.ZeroDec(),
+		Description:       description,
+		BondHeight:        int64(0),
+		UnbondingHeight:   int64(0),
+		UnbondingMinTime:  time.Unix(0, 0).UTC(),
+		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
 	}
 }
 
 // what's kept in the store value
 type validatorValue struct {
-	ConsPubKey       crypto.PubKey
-	Jailed           bool
-	Status           sdk.BondStatus
-	Tokens           sdk.Dec
-	DelegatorShares  sdk.Dec
-	Description      Description
-	BondHeight       int64
-	UnbondingHeight  int64
-	UnbondingMinTime time.Time
-	Commission       Commission
+	ConsPubKey        crypto.PubKey
+	Jailed            bool
+	Status            sdk.BondStatus
+	Tokens            sdk.Dec
+	DelegatorShares   sdk.Dec
+	Description       Description
+	BondHeight        int64
+	UnbondingHeight   int64
+	UnbondingMinTime  time.Time
+	Commission        Commission
+	PendingCommission *PendingCommission
+	MinSelfDelegation sdk.Int
+	JailReason        string
 }
 
 // return the redelegation without fields contained within the key for the store
 func MustMarshalValidator(cdc *codec.Codec, validator Validator) []byte {
 	val := validatorValue{
-		ConsPubKey:       validator.ConsPubKey,
-		Jailed:           validator.Jailed,
-		Status:           validator.Status,
-		Tokens:           validator.Tokens,
-		DelegatorShares:  validator.DelegatorShares,
-		Description:      validator.Description,
-		BondHeight:       validator.BondHeight,
-		UnbondingHeight:  validator.UnbondingHeight,
-		UnbondingMinTime: validator.UnbondingMinTime,
-		Commission:       validator.Commission,
+		ConsPubKey:        validator.ConsPubKey,
+		Jailed:            validator.Jailed,
+		Status:            validator.Status,
+		Tokens:            validator.Tokens,
+		DelegatorShares:   validator.DelegatorShares,
+		Description:       validator.Description,
+		BondHeight:        validator.BondHeight,
+		UnbondingHeight:   validator.UnbondingHeight,
+		UnbondingMinTime:  validator.UnbondingMinTime,
+		Commission:        validator.Commission,
+		PendingCommission: validator.PendingCommission,
+		MinSelfDelegation: validator.MinSelfDelegation,
+		JailReason:        validator.JailReason,
 	}
 	return cdc.MustMarshalBinaryLengthPrefixed(val)
 }
@@ -108,17 +119,20 @@ func UnmarshalValidator(cdc *codec.Codec, operatorAddr, value []byte) (validator
 	}
 
 	return Validator{
-		OperatorAddr:     operatorAddr,
-		ConsPubKey:       storeValue.ConsPubKey,
-		Jailed:           storeValue.Jailed,
-		Tokens:           storeValue.Tokens,
-		Status:           storeValue.Status,
-		DelegatorShares:  storeValue.DelegatorShares,
-		Description:      storeValue.Description,
-		BondHeight:       storeValue.BondHeight,
-		UnbondingHeight:  storeValue.UnbondingHeight,
-		UnbondingMinTime: storeValue.UnbondingMinTime,
-		Commission:       storeValue.Commission,
+		OperatorAddr:      operatorAddr,
+		ConsPubKey:        storeValue.ConsPubKey,
+		Jailed:            storeValue.Jailed,
+		Tokens:            storeValue.Tokens,
+		Status:            storeValue.Status,
+		DelegatorShares:   storeValue.DelegatorShares,
+		Description:       storeValue.Description,
+		BondHeight:        storeValue.BondHeight,
+		UnbondingHeight:   storeValue.UnbondingHeight,
+		UnbondingMinTime:  storeValue.UnbondingMinTime,
+		Commission:        storeValue.Commission,
+		PendingCommission: storeValue.PendingCommission,
+		MinSelfDelegation: minSelfDelegationOrZero(storeValue.MinSelfDelegation),
+		JailReason:        storeValue.JailReason,
 	}, nil
 }
 
@@ -143,6 +157,13 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Unbonding Height: %d\n", v.UnbondingHeight)
 	resp += fmt.Sprintf("Minimum Unbonding Time: %v\n", v.UnbondingMinTime)
 	resp += fmt.Sprintf("Commission: {%s}\n", v.Commission)
+	if v.PendingCommission != nil {
+		resp += fmt.Sprintf("Pending Commission: {%s}\n", *v.PendingCommission)
+	}
+	resp += fmt.Sprintf("Minimum Self Delegation: %s\n", v.MinSelfDelegation)
+	if len(v.JailReason) > 0 {
+		resp += fmt.Sprintf("Jail Reason: %s\n", v.JailReason)
+	}
 
 	return resp, nil
 }
@@ -165,7 +186,11 @@ type bechValidator struct {
 	UnbondingHeight  int64     `json:"unbonding_height"` // if unbonding, height at which this validator has begun unbonding
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
-	Commission Commission `json:"commission"` // commission parameters
+	Commission        Commission         `json:"commission"`         // commission parameters
+	PendingCommission *PendingCommission `json:"pending_commission"` // commission change announced and not applied yet
+
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"` // minimum tokens of the operator's self-delegation
+	JailReason        string  `json:"jail_reason"`         // reason of the jailing by the stake module, if any
 }
 
 // MarshalJSON marshals the validator to JSON using Bech32
@@ -176,17 +201,20 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 	}
 
 	return codec.Cdc.MarshalJSON(bechValidator{
-		OperatorAddr:     v.OperatorAddr,
-		ConsPubKey:       bechConsPubKey,
-		Jailed:           v.Jailed,
-		Status:           v.Status,
-		Tokens:           v.Tokens,
-		DelegatorShares:  v.DelegatorShares,
-		Description:      v.Description,
-		BondHeight:       v.BondHeight,
-		UnbondingHeight:  v.UnbondingHeight,
-		UnbondingMinTime: v.UnbondingMinTime,
-		Commission:       v.Commission,
+		OperatorAddr:      v.OperatorAddr,
+		ConsPubKey:        bechConsPubKey,
+		Jailed:            v.Jailed,
+		Status:            v.Status,
+		Tokens:            v.Tokens,
+		DelegatorShares:   v.DelegatorShares,
+		Description:       v.Description,
+		BondHeight:        v.BondHeight,
+		UnbondingHeight:   v.UnbondingHeight,
+		UnbondingMinTime:  v.UnbondingMinTime,
+		Commission:        v.Commission,
+		PendingCommission: v.PendingCommission,
+		MinSelfDelegation: v.MinSelfDelegation,
+		JailReason:        v.JailReason,
 	})
 }
 
@@ -201,17 +229,20 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	*v = Validator{
-		OperatorAddr:     bv.OperatorAddr,
-		ConsPubKey:       consPubKey,
-		Jailed:           bv.Jailed,
-		Tokens:           bv.Tokens,
-		Status:           bv.Status,
-		DelegatorShares:  bv.DelegatorShares,
-		Description:      bv.Description,
-		BondHeight:       bv.BondHeight,
-		UnbondingHeight:  bv.UnbondingHeight,
-		UnbondingMinTime: bv.UnbondingMinTime,
-		Commission:       bv.Commission,
+		OperatorAddr:      bv.OperatorAddr,
+		ConsPubKey:        consPubKey,
+		Jailed:            bv.Jailed,
+		Tokens:            bv.Tokens,
+		Status:            bv.Status,
+		DelegatorShares:   bv.DelegatorShares,
+		Description:       bv.Description,
+		BondHeight:        bv.BondHeight,
+		UnbondingHeight:   bv.UnbondingHeight,
+		UnbondingMinTime:  bv.UnbondingMinTime,
+		Commission:        bv.Commission,
+		PendingCommission: bv.PendingCommission,
+		MinSelfDelegation: minSelfDelegationOrZero(bv.MinSelfDelegation),
+		JailReason:        bv.JailReason,
 	}
 	return nil
 }
@@ -229,11 +260,28 @@ func (v Validator) Equal(v2 Validator) bool {
 		v.Commission.Equal(v2.Commission)
 }
 
+// validators stored before the minimum self-delegation was introduced have none
+func minSelfDelegationOrZero(minSelfDelegation sdk.Int) sdk.Int {
+	if minSelfDelegation == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return minSelfDelegation
+}
+
+// SelfDelegationBelowMinimum returns true if the tokens of the given self-delegation
+// shares are less than the minimum self-delegation of the validator
+func (v Validator) SelfDelegationBelowMinimum(selfDelShares sdk.Dec) bool {
+	return v.DelegatorShareExRate().Mul(selfDelShares).LT(sdk.NewDecFromInt(v.MinSelfDelegation))
+}
+
 // return the TM validator address
 func (v Validator) ConsAddress() sdk.ConsAddress {
 	return sdk.ConsAddress(v.ConsPubKey.Address())
 }
 
+// reason recorded on a validator jailed for the self-delegation of its operator
+const JailReasonMinSelfDelegation = "self-delegation below the minimum self-delegation"
+
 // constant used in flags to indicate that description field should not be updated
 const DoNotModifyDesc = "[do-not-modify]"
 
@@ -463,7 +511,8 @@ func (v Validator) GetPower() sdk.Dec {
 func (v Validator) GetPotentialPower() sdk.Dec {
 	return v.Tokens.QuoInt(sdk.AttoScaleFactor)
 }
-func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
-func (v Validator) GetCommission() sdk.Dec      { return v.Commission.Rate }
-func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
-func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+func (v Validator) GetTokens() sdk.Dec            { return v.Tokens }
+func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
+func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+func (v Validator) GetBondHeight() int64          { return v.BondHeight }
+func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
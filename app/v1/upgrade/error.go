@@ -7,12 +7,13 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = "upgrade"
 
-	CodeInvalidMsgType     sdk.CodeType = 100
-	CodeUnSupportedMsgType sdk.CodeType = 101
-	CodeUnknownRequest     sdk.CodeType = sdk.CodeUnknownRequest
-	CodeNotCurrentProposal sdk.CodeType = 102
-	CodeNotValidator       sdk.CodeType = 103
-	CodeDoubleSwitch       sdk.CodeType = 104
+	CodeInvalidMsgType      sdk.CodeType = 100
+	CodeUnSupportedMsgType  sdk.CodeType = 101
+	CodeUnknownRequest      sdk.CodeType = sdk.CodeUnknownRequest
+	CodeNotCurrentProposal  sdk.CodeType = 102
+	CodeNotValidator        sdk.CodeType = 103
+	CodeDoubleSwitch        sdk.CodeType = 104
+	CodeNoUpgradeInProgress sdk.CodeType = 105
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -26,6 +27,10 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 	}
 }
 
+func ErrNoUpgradeInProgress(codespace sdk.CodespaceType) sdk.Error {
+	return NewError(codespace, CodeNoUpgradeInProgress, "No software upgrade switch period is in process")
+}
+
 func NewError(codespace sdk.CodespaceType, code sdk.CodeType, msg string) sdk.Error {
 	msg = msgOrDefaultMsg(msg, code)
 	return sdk.NewError(codespace, code, msg)
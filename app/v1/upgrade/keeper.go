@@ -47,6 +47,16 @@ func (k Keeper) AddNewVersionInfo(ctx sdk.Context, versionInfo VersionInfo) {
 	}
 }
 
+// CancelUpgrade clears the upgrade in progress, which is recorded as a failed version so that it can be proposed again
+func (k Keeper) CancelUpgrade(ctx sdk.Context, upgradeConfig sdk.UpgradeConfig) {
+	versionInfo := NewVersionInfo(upgradeConfig, false)
+	versionInfo.Cancelled = true
+
+	k.protocolKeeper.SetLastFailedVersion(ctx, upgradeConfig.Protocol.Version)
+	k.AddNewVersionInfo(ctx, versionInfo)
+	k.protocolKeeper.ClearUpgradeConfig(ctx)
+}
+
 func (k Keeper) SetSignal(ctx sdk.Context, protocol uint64, address string) {
 	kvStore := ctx.KVStore(k.storeKey)
 	cmsgBytes, err := k.cdc.MarshalBinaryLengthPrefixed(true)
@@ -78,3 +88,38 @@ func (k Keeper) DeleteSignal(ctx sdk.Context, protocol uint64, address string) b
 	}
 	return false
 }
+
+// GetUpgradeSignals returns the signal state of every bonded validator for the upgrade in progress
+func (k Keeper) GetUpgradeSignals(ctx sdk.Context, upgradeConfig sdk.UpgradeConfig) UpgradeSignals {
+	signals := UpgradeSignals{
+		UpgradeConfig:        upgradeConfig,
+		SignalledVotingPower: sdk.ZeroDec(),
+		TotalVotingPower:     sdk.ZeroDec(),
+		SignalledShare:       sdk.ZeroDec(),
+		Validators:           []ValidatorSignal{},
+	}
+
+	k.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator sdk.Validator) (stop bool) {
+		signalled := k.GetSignal(ctx, upgradeConfig.Protocol.Version, validator.GetConsAddr().String())
+		signals.TotalVotingPower = signals.TotalVotingPower.Add(validator.GetPower())
+		if signalled {
+			signals.SignalledVotingPower = signals.SignalledVotingPower.Add(validator.GetPower())
+		}
+		signals.Validators = append(signals.Validators, ValidatorSignal{
+			Operator:    validator.GetOperator(),
+			ConsAddress: validator.GetConsAddr(),
+			VotingPower: validator.GetPower(),
+			Signalled:   signalled,
+		})
+		return false
+	})
+
+	if signals.TotalVotingPower.IsPositive() {
+		signals.SignalledShare = signals.SignalledVotingPower.Quo(signals.TotalVotingPower)
+	}
+	// the signals are tallied at the end of the block before the switch height
+	if tallyHeight := upgradeConfig.Protocol.Height - 1; tallyHeight > uint64(ctx.BlockHeight()) {
+		signals.BlocksLeft = tallyHeight - uint64(ctx.BlockHeight())
+	}
+	return signals
+}
@@ -0,0 +1,36 @@
+package upgrade
+
+import (
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the upgrade Querier
+const (
+	QuerySignals = "signals"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QuerySignals:
+			return querySignals(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func querySignals(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	upgradeConfig, found := k.protocolKeeper.GetUpgradeConfig(ctx)
+	if !found {
+		return nil, ErrNoUpgradeInProgress(DefaultCodespace)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetUpgradeSignals(ctx, upgradeConfig))
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
@@ -1,17 +1,57 @@
 package upgrade
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
 type VersionInfo struct {
 	UpgradeInfo sdk.UpgradeConfig
 	Success     bool
+	Cancelled   bool // cancelled by governance before the switch height
 }
 
 func NewVersionInfo(upgradeConfig sdk.UpgradeConfig, success bool) VersionInfo {
 	return VersionInfo{
-		upgradeConfig,
-		success,
+		UpgradeInfo: upgradeConfig,
+		Success:     success,
+	}
+}
+
+// ValidatorSignal is the signal state of a bonded validator for the upgrade in progress
+type ValidatorSignal struct {
+	Operator    sdk.ValAddress  `json:"operator"`
+	ConsAddress sdk.ConsAddress `json:"cons_address"`
+	VotingPower sdk.Dec         `json:"voting_power"`
+	Signalled   bool            `json:"signalled"`
+}
+
+// UpgradeSignals is the readiness report of the upgrade in progress
+type UpgradeSignals struct {
+	UpgradeConfig        sdk.UpgradeConfig `json:"upgrade_config"`
+	SignalledVotingPower sdk.Dec           `json:"signalled_voting_power"`
+	TotalVotingPower     sdk.Dec           `json:"total_voting_power"`
+	SignalledShare       sdk.Dec           `json:"signalled_share"` // signalled voting power / total voting power, must be greater than the threshold to upgrade
+	BlocksLeft           uint64            `json:"blocks_left"`     // blocks left before the signals are tallied
+	Validators           []ValidatorSignal `json:"validators"`
+}
+
+func (us UpgradeSignals) String() string {
+	out := fmt.Sprintf(`Upgrade Signals:
+  Upgrade In Progress:     %s
+  Signalled Voting Power:  %s
+  Total Voting Power:      %s
+  Signalled Share:         %s
+  Threshold:               %s
+  Blocks Left:             %d`,
+		us.UpgradeConfig, us.SignalledVotingPower, us.TotalVotingPower, us.SignalledShare,
+		us.UpgradeConfig.Protocol.Threshold, us.BlocksLeft)
+	if len(us.Validators) > 0 {
+		out += "\n  Validators:"
+		for _, val := range us.Validators {
+			out += fmt.Sprintf("\n    %s  %s  signalled: %v", val.Operator, val.VotingPower, val.Signalled)
+		}
 	}
+	return out
 }
@@ -25,6 +25,11 @@ func (p *ProtocolV2) runtimeInvariants() []sdk.Invariant {
 	}
 }
 
+// GetInvariants returns the runtime invariants of this Protocol
+func (p *ProtocolV2) GetInvariants() []sdk.Invariant {
+	return p.runtimeInvariants()
+}
+
 func (p *ProtocolV2) assertRuntimeInvariants(ctx sdk.Context) {
 	if p.invariantLevel != sdk.InvariantError && p.invariantLevel != sdk.InvariantPanic {
 		return
@@ -17,6 +17,7 @@ const (
 	FlagInitialSupply   = "initial-supply"
 	FlagMaxSupply       = "max-supply"
 	FlagMintable        = "mintable"
+	FlagVesting         = "vesting"
 
 	FlagOwner    = "owner"
 	FlagMoniker  = "moniker"
@@ -25,8 +26,9 @@ const (
 	FlagWebsite  = "website"
 	FlagTo       = "to"
 
-	FlagToken  = "token"
-	FlagAmount = "amount"
+	FlagToken       = "token"
+	FlagAmount      = "amount"
+	FlagBeneficiary = "beneficiary"
 )
 
 var (
@@ -39,6 +41,7 @@ var (
 	FsFeeQuery             = flag.NewFlagSet("", flag.ContinueOnError)
 	FsTransferTokenOwner   = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMintToken            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsVestingsQuery        = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -53,6 +56,7 @@ func init() {
 	FsTokenIssue.Uint64(FlagInitialSupply, 0, "the initial supply token of token")
 	FsTokenIssue.Uint64(FlagMaxSupply, asset.MaximumAssetMaxSupply, "the max supply of the token")
 	FsTokenIssue.Bool(FlagMintable, false, "whether the token can be minted, default false")
+	FsTokenIssue.StringArray(FlagVesting, nil, "a time-locked part of the initial supply in the format <amount>,<beneficiary>,<release-height|release-time(RFC3339)>, can be repeated")
 
 	FsTokensQuery.String(FlagSource, "", "the asset source, valid values can be native, external and gateway")
 	FsTokensQuery.String(FlagGateway, "", "the gateway name of gateway token. required if --source=gateway")
@@ -84,4 +88,7 @@ func init() {
 
 	FsMintToken.String(FlagTo, "", "address of mint token to")
 	FsMintToken.Uint64(FlagAmount, 0, "amount of mint token")
+
+	FsVestingsQuery.String(FlagToken, "", "the token id to be queried")
+	FsVestingsQuery.String(FlagBeneficiary, "", "the beneficiary address to be queried")
 }
@@ -231,6 +231,56 @@ func GetCmdQueryFee(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryVestings implements the query token vestings command.
+func GetCmdQueryVestings(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-vestings",
+		Short:   "Query the pending vestings of a token or a beneficiary",
+		Example: "iriscli asset query-vestings --token=<token id> --beneficiary=<address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QueryVestingsParams{
+				TokenId: viper.GetString(FlagToken),
+			}
+
+			if len(params.TokenId) == 0 && len(viper.GetString(FlagBeneficiary)) == 0 {
+				return fmt.Errorf("must specify the token or beneficiary to be queried")
+			}
+
+			if len(viper.GetString(FlagBeneficiary)) > 0 {
+				beneficiary, err := sdk.AccAddressFromBech32(viper.GetString(FlagBeneficiary))
+				if err != nil {
+					return err
+				}
+				params.Beneficiary = beneficiary
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryVestings), bz)
+			if err != nil {
+				return err
+			}
+
+			var vestings asset.TokenVestings
+			err = cdc.UnmarshalJSON(res, &vestings)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(vestings)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsVestingsQuery)
+
+	return cmd
+}
+
 // preQueryFeeCmd is used to check if the specified flags are valid
 func preQueryFeeCmd(cmd *cobra.Command, args []string) error {
 	flags := cmd.Flags()
@@ -30,7 +30,7 @@ func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
 		Use:   "issue-token",
 		Short: "Issue a new token",
 		Example: "iriscli asset issue-token --family=<family> --source=<source> --gateway=<gateway-moniker> --decimal=<decimal>" +
-			" --symbol=<symbol> --name=<token-name> --initial-supply=<initial-supply> --from=<key-name> --chain-id=<chain-id> --fee=0.6iris",
+			" --symbol=<symbol> --name=<token-name> --initial-supply=<initial-supply> --vesting=<amount>,<beneficiary>,<release-height> --from=<key-name> --chain-id=<chain-id> --fee=0.6iris",
 		PreRun: preSignCmd,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().
@@ -70,6 +70,19 @@ func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
 				Owner:           owner,
 			}
 
+			vestings, err := cmd.Flags().GetStringArray(FlagVesting)
+			if err != nil {
+				return err
+			}
+
+			for _, vesting := range vestings {
+				entry, err := parseVestingEntry(vesting)
+				if err != nil {
+					return err
+				}
+				msg.VestingSchedule = append(msg.VestingSchedule, entry)
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -2,10 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/client/context"
+	sdk "github.com/irisnet/irishub/types"
 )
 
 // queryGatewayFee retrieves the gateway creation fee for the specified moniker
@@ -61,3 +65,33 @@ func queryTokenFees(cliCtx context.CLIContext, tokenID string) (asset.TokenFeesO
 
 	return out, nil
 }
+
+// parseVestingEntry parses a vesting entry in the format <amount>,<beneficiary>,<release-height|release-time>
+func parseVestingEntry(str string) (asset.VestingEntry, error) {
+	parts := strings.Split(str, ",")
+	if len(parts) != 3 {
+		return asset.VestingEntry{}, fmt.Errorf("invalid vesting %s, expected <amount>,<beneficiary>,<release-height|release-time>", str)
+	}
+
+	amount, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return asset.VestingEntry{}, fmt.Errorf("invalid vesting amount %s: %s", parts[0], err.Error())
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return asset.VestingEntry{}, err
+	}
+
+	release := strings.TrimSpace(parts[2])
+	if height, err := strconv.ParseInt(release, 10, 64); err == nil {
+		return asset.NewVestingEntry(height, time.Time{}, amount, beneficiary), nil
+	}
+
+	releaseTime, err := time.Parse(time.RFC3339, release)
+	if err != nil {
+		return asset.VestingEntry{}, fmt.Errorf("invalid vesting release %s, expected a block height or an RFC3339 time", release)
+	}
+
+	return asset.NewVestingEntry(0, releaseTime.UTC(), amount, beneficiary), nil
+}
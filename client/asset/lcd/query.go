@@ -42,6 +42,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/asset/fees/tokens/{id}",
 		tokenFeesHandlerFn(cliCtx, cdc),
 	).Methods("GET")
+
+	// Get the pending vestings of a token with an optional beneficiary
+	r.HandleFunc(
+		"/asset/tokens/{id}/vestings",
+		tokenVestingsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the pending vestings of a beneficiary
+	r.HandleFunc(
+		"/asset/vestings/{beneficiary}",
+		beneficiaryVestingsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
 }
 
 // queryTokenHandlerFn performs token information query
@@ -73,3 +85,13 @@ func gatewayFeeHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handl
 func tokenFeesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return queryTokenFees(cliCtx, cdc, "custom/asset/fees/tokens")
 }
+
+// tokenVestingsHandlerFn is the HTTP request handler to query the vestings of a token
+func tokenVestingsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryTokenVestings(cliCtx, cdc, "custom/asset/vestings")
+}
+
+// beneficiaryVestingsHandlerFn is the HTTP request handler to query the vestings of a beneficiary
+func beneficiaryVestingsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryBeneficiaryVestings(cliCtx, cdc, "custom/asset/vestings")
+}
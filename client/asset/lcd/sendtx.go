@@ -56,19 +56,20 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 }
 
 type issueTokenReq struct {
-	BaseTx          utils.BaseTx      `json:"base_tx"`
-	Owner           sdk.AccAddress    `json:"owner"` //  Owner of the token
-	Family          asset.AssetFamily `json:"family"`
-	Source          asset.AssetSource `json:"source"`
-	Gateway         string            `json:"gateway"`
-	Symbol          string            `json:"symbol"`
-	CanonicalSymbol string            `json:"canonical_symbol"`
-	Name            string            `json:"name"`
-	Decimal         uint8             `json:"decimal"`
-	MinUnitAlias    string            `json:"min_unit_alias"`
-	InitialSupply   uint64            `json:"initial_supply"`
-	MaxSupply       uint64            `json:"max_supply"`
-	Mintable        bool              `json:"mintable"`
+	BaseTx          utils.BaseTx         `json:"base_tx"`
+	Owner           sdk.AccAddress       `json:"owner"` //  Owner of the token
+	Family          asset.AssetFamily    `json:"family"`
+	Source          asset.AssetSource    `json:"source"`
+	Gateway         string               `json:"gateway"`
+	Symbol          string               `json:"symbol"`
+	CanonicalSymbol string               `json:"canonical_symbol"`
+	Name            string               `json:"name"`
+	Decimal         uint8                `json:"decimal"`
+	MinUnitAlias    string               `json:"min_unit_alias"`
+	InitialSupply   uint64               `json:"initial_supply"`
+	MaxSupply       uint64               `json:"max_supply"`
+	Mintable        bool                 `json:"mintable"`
+	VestingSchedule []asset.VestingEntry `json:"vesting_schedule"`
 }
 
 type createGatewayReq struct {
@@ -219,6 +220,7 @@ func issueTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 
 		// create the MsgEditGateway message
 		msg := asset.NewMsgIssueToken(req.Family, req.Source, req.Gateway, req.Symbol, req.CanonicalSymbol, req.Name, req.Decimal, req.MinUnitAlias, req.InitialSupply, req.MaxSupply, req.Mintable, req.Owner)
+		msg.VestingSchedule = req.VestingSchedule
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -205,3 +205,52 @@ func queryTokenFees(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 		utils.PostProcessResponse(w, cliCtx.Codec, res, cliCtx.Indent)
 	}
 }
+
+// queryTokenVestings queries the vestings of a token with an optional beneficiary from the specified endpoint
+func queryTokenVestings(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := asset.QueryVestingsParams{
+			TokenId: mux.Vars(r)["id"],
+		}
+
+		if beneficiaryStr := r.FormValue("beneficiary"); len(beneficiaryStr) > 0 {
+			beneficiary, err := sdk.AccAddressFromBech32(beneficiaryStr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Beneficiary = beneficiary
+		}
+
+		queryVestings(w, cliCtx, endpoint, params)
+	}
+}
+
+// queryBeneficiaryVestings queries the vestings of a beneficiary from the specified endpoint
+func queryBeneficiaryVestings(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		beneficiary, err := sdk.AccAddressFromBech32(mux.Vars(r)["beneficiary"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryVestings(w, cliCtx, endpoint, asset.QueryVestingsParams{Beneficiary: beneficiary})
+	}
+}
+
+func queryVestings(w http.ResponseWriter, cliCtx context.CLIContext, endpoint string, params asset.QueryVestingsParams) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := cliCtx.QueryWithData(endpoint, bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.PostProcessResponse(w, cliCtx.Codec, res, cliCtx.Indent)
+}
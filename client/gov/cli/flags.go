@@ -8,6 +8,7 @@ const (
 	flagDeposit      = "deposit"
 	flagVoter        = "voter"
 	flagOption       = "option"
+	flagOptions      = "options"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
@@ -23,6 +24,13 @@ const (
 	flagSoftware     = "software"
 	flagSwitchHeight = "switch-height"
 	flagThreshold    = "threshold"
+	flagCancel       = "cancel"
+	flagMsgsFile     = "msgs-file"
+
+	flagDelegator      = "delegator"
+	flagRepresentative = "representative"
+	flagPage           = "page"
+	flagSize           = "size"
 
 	//for addTokenProposal
 	flagTokenSymbol          = "token-symbol"
@@ -322,3 +322,217 @@ func GetCmdQueryTally(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTallySimulation implements the command to simulate the tally of a proposal in the voting period.
+func GetCmdQueryTallySimulation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-tally",
+		Short:   "Simulate the tally of a proposal in the voting period with the current votes",
+		Example: "iriscli gov simulate-tally --proposal-id=4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			params := gov.QueryTallySimulationParams{
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryTallySimulation), bz)
+			if err != nil {
+				return err
+			}
+
+			var simulation gov.TallySimulation
+			if err := cdc.UnmarshalJSON(res, &simulation); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(simulation)
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal is being simulated")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdQueryProposalRecord implements the command to query the final record of a proposal.
+func GetCmdQueryProposalRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-proposal-record",
+		Short:   "Query the final record of a proposal persisted at the end of its voting period",
+		Example: "iriscli gov query-proposal-record --proposal-id=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			params := gov.QueryProposalRecordParams{
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryProposalRecord), bz)
+			if err != nil {
+				return err
+			}
+
+			var record gov.ProposalRecord
+			if err := cdc.UnmarshalJSON(res, &record); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(record)
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of the proposal record being queried")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdQueryProposalRecords implements the command to page through the records of the past proposals.
+func GetCmdQueryProposalRecords(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-proposal-records",
+		Short:   "Query the records of the past proposals with optional filters",
+		Example: "iriscli gov query-proposal-records --status=Passed --type=Parameter --page=1 --size=20",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strProposalStatus := viper.GetString(flagStatus)
+			strProposalType := viper.GetString(flagProposalType)
+			pagination := sdk.NewPaginationParams(uint64(viper.GetInt64(flagPage)), uint16(viper.GetInt(flagSize)))
+
+			params := gov.QueryProposalRecordsParams{
+				Page: pagination.Page,
+				Size: pagination.Size,
+			}
+
+			if len(strProposalStatus) > 0 {
+				params.ProposalStatus = client.NormalizeProposalStatus(strProposalStatus)
+				if _, err := gov.ProposalStatusFromString(params.ProposalStatus); err != nil {
+					return err
+				}
+			}
+
+			if len(strProposalType) > 0 {
+				params.ProposalType = client.NormalizeProposalType(strProposalType)
+				if _, err := gov.ProposalTypeFromString(params.ProposalType); err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryProposalRecords), bz)
+			if err != nil {
+				return err
+			}
+
+			var records gov.ProposalRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "filter records by proposal status, status: Passed/Rejected")
+	cmd.Flags().String(flagProposalType, "", "filter records by proposal type")
+	cmd.Flags().Uint64(flagPage, 1, "the page number of the records, starting from 1")
+	cmd.Flags().Uint16(flagSize, 100, "the number of records per page, no more than 100")
+	return cmd
+}
+
+// GetCmdQueryRepresentative implements the command to query the representative of a delegator.
+func GetCmdQueryRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-representative",
+		Short:   "Query the governance representative of a delegator",
+		Example: "iriscli gov query-representative --delegator=<delegator address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagDelegator))
+			if err != nil {
+				return err
+			}
+
+			params := gov.QueryRepresentativeParams{
+				Delegator: delegatorAddr,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryRepresentative), bz)
+			if err != nil {
+				return err
+			}
+
+			var representative sdk.AccAddress
+			if err := cdc.UnmarshalJSON(res, &representative); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(representative)
+		},
+	}
+
+	cmd.Flags().String(flagDelegator, "", "bech32 delegator address")
+	cmd.MarkFlagRequired(flagDelegator)
+	return cmd
+}
+
+// GetCmdQueryRepresentedDelegators implements the command to query the delegators represented by a representative.
+func GetCmdQueryRepresentedDelegators(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-represented-delegators",
+		Short:   "Query the delegators represented by a governance representative",
+		Example: "iriscli gov query-represented-delegators --representative=<representative address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			representativeAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagRepresentative))
+			if err != nil {
+				return err
+			}
+
+			params := gov.QueryRepresentedDelegatorsParams{
+				Representative: representativeAddr,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryRepresentedDelegators), bz)
+			if err != nil {
+				return err
+			}
+
+			var delegators gov.RepresentedDelegators
+			if err := cdc.UnmarshalJSON(res, &delegators); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(delegators)
+		},
+	}
+
+	cmd.Flags().String(flagRepresentative, "", "bech32 representative address")
+	cmd.MarkFlagRequired(flagRepresentative)
+	return cmd
+}
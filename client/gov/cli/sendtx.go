@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -111,6 +112,28 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 			}
 
+			if proposalType == gov.ProposalTypeUpgradeReschedule {
+				version := uint64(viper.GetInt64(flagVersion))
+				cancel := viper.GetBool(flagCancel)
+
+				switchHeightInt := viper.GetInt64(flagSwitchHeight)
+				if switchHeightInt < 0 {
+					return errors.Errorf("SwitchHeight must greater than or equal to zero")
+				}
+				switchHeight := uint64(switchHeightInt)
+
+				// the threshold is kept unless specified
+				threshold := sdk.ZeroDec()
+				if cmd.Flags().Changed(flagThreshold) {
+					threshold, err = sdk.NewDecFromStr(viper.GetString(flagThreshold))
+					if err != nil {
+						return err
+					}
+				}
+				msg := gov.NewMsgSubmitUpgradeRescheduleProposal(msg, version, cancel, switchHeight, threshold)
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+			}
+
 			if proposalType == gov.ProposalTypeTokenAddition {
 				symbol := viper.GetString(flagTokenSymbol)
 				canonicalSymbol := viper.GetString(flagTokenCanonicalSymbol)
@@ -121,13 +144,27 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				msg := gov.NewMsgSubmitTokenAdditionProposal(msg, symbol, canonicalSymbol, name, alias, decimal)
 				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 			}
+
+			if proposalType == gov.ProposalTypeMsgs {
+				bz, err := ioutil.ReadFile(viper.GetString(flagMsgsFile))
+				if err != nil {
+					return err
+				}
+				var proposalMsgs []sdk.Msg
+				if err := cdc.UnmarshalJSON(bz, &proposalMsgs); err != nil {
+					return err
+				}
+
+				msg := gov.NewMsgSubmitMsgsProposal(msg, proposalMsgs)
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+			}
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal,eg:PlainText/Parameter/SoftwareUpgrade/SystemHalt/CommunityTaxUsage/TokenAddition")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal,eg:PlainText/Parameter/SoftwareUpgrade/UpgradeReschedule/SystemHalt/CommunityTaxUsage/TokenAddition/Msgs")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal(at least 30% of MinDeposit)")
 	cmd.Flags().String(flagParam, "", "parameter of proposal,eg. key=value")
 	cmd.Flags().String(flagUsage, "", "the transaction fee tax usage type, valid values can be Burn, Distribute and Grant")
@@ -138,6 +175,7 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagSoftware, " ", "the software of the new protocol")
 	cmd.Flags().String(flagSwitchHeight, "0", "the switchheight of the new protocol")
 	cmd.Flags().String(flagThreshold, "0.8", "the upgrade signal threshold of the software upgrade")
+	cmd.Flags().Bool(flagCancel, false, "cancel the software upgrade in progress instead of rescheduling it")
 
 	//for TokenAdditionProposal
 	cmd.Flags().String(flagTokenSymbol, "", "the asset symbol. Once created, it cannot be modified")
@@ -146,6 +184,9 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Uint8(flagTokenDecimal, 0, "the asset decimal. The maximum value is 18")
 	cmd.Flags().String(flagTokenMinUnitAlias, "", "the asset symbol minimum alias")
 
+	//for MsgsProposal
+	cmd.Flags().String(flagMsgsFile, "", "JSON file of the msgs executed by the governance account, eg. [{\"type\":\"irishub/bank/Send\",\"value\":{...}}]")
+
 	cmd.MarkFlagRequired(flagTitle)
 	cmd.MarkFlagRequired(flagDescription)
 	cmd.MarkFlagRequired(flagProposalType)
@@ -212,6 +253,83 @@ func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdCancelProposal implements cancelling a proposal in the deposit period.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-proposal",
+		Short:   "Cancel a proposal in the deposit period by the proposer",
+		Example: "iriscli gov cancel-proposal --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --proposal-id=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			proposerAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			msg := gov.NewMsgCancelProposal(proposerAddr, proposalID)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal to cancel")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdSetRepresentative implements setting the governance representative of a delegator.
+func GetCmdSetRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-representative",
+		Short:   "Set the representative voting on behalf of the delegator, omit the representative to remove the current one",
+		Example: "iriscli gov set-representative --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --representative=<representative address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var representativeAddr sdk.AccAddress
+			if representativeStr := viper.GetString(flagRepresentative); len(representativeStr) > 0 {
+				representativeAddr, err = sdk.AccAddressFromBech32(representativeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := gov.NewMsgSetRepresentative(delegatorAddr, representativeAddr)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRepresentative, "", "bech32 address of the representative, omit to remove the current one")
+	return cmd
+}
+
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -263,3 +381,51 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd.MarkFlagRequired(flagOption)
 	return cmd
 }
+
+// GetCmdWeightedVote implements splitting the voting power on several options.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "weighted-vote",
+		Short:   "Vote for an active proposal with several options by weights summing to 1",
+		Example: "iriscli gov weighted-vote --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --proposal-id=1 --options=Yes=0.6,No=0.4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			voterAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+			options, err := client.NormalizeWeightedVoteOptions(viper.GetString(flagOptions))
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgWeightedVote(voterAddr, proposalID, options)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Vote[Voter:%s,ProposalID:%d,Options:%s]",
+				voterAddr.String(), msg.ProposalID, msg.Options.String(),
+			)
+			cliCtx.PrintResponse = true
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
+	cmd.Flags().String(flagOptions, "", "vote options with weights summing to 1, eg. Yes=0.6,No=0.4")
+	cmd.MarkFlagRequired(flagProposalID)
+	cmd.MarkFlagRequired(flagOptions)
+	return cmd
+}
@@ -6,4 +6,9 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestDelegator      = "delegator"
+	RestRepresentative = "representative"
+	RestProposalType   = "type"
+	RestPage           = "page"
+	RestSize           = "size"
 )
@@ -24,6 +24,8 @@ type postProposalReq struct {
 	CommTax        commTax        `json:"comm_tax"`
 	Token          token          `json:"token"`
 	Upgrade        upgrade        `json:"upgrade"`
+	Reschedule     reschedule     `json:"reschedule"`
+	Msgs           []sdk.Msg      `json:"msgs"` // msgs executed by the governance account
 }
 
 type token struct {
@@ -41,6 +43,13 @@ type upgrade struct {
 	Threshold    sdk.Dec `json:"threshold"`
 }
 
+type reschedule struct {
+	Version      uint64  `json:"version"`
+	Cancel       bool    `json:"cancel"`
+	SwitchHeight uint64  `json:"switch_height"`
+	Threshold    sdk.Dec `json:"threshold"`
+}
+
 type commTax struct {
 	Usage       gov.UsageType  `json:"usage"`
 	DestAddress sdk.AccAddress `json:"dest_address"`
@@ -53,12 +62,28 @@ type depositReq struct {
 	Amount    string         `json:"amount"`    // Coins to add to the proposal's deposit
 }
 
+type cancelProposalReq struct {
+	BaseTx   utils.BaseTx   `json:"base_tx"`
+	Proposer sdk.AccAddress `json:"proposer"` // Address of the proposer
+}
+
+type setRepresentativeReq struct {
+	BaseTx         utils.BaseTx   `json:"base_tx"`
+	Representative sdk.AccAddress `json:"representative"` // address of the representative, empty to remove the current one
+}
+
 type voteReq struct {
 	BaseTx utils.BaseTx   `json:"base_tx"`
 	Voter  sdk.AccAddress `json:"voter"`  //  address of the voter
 	Option string         `json:"option"` //  option from OptionSet chosen by the voter
 }
 
+type weightedVoteReq struct {
+	BaseTx  utils.BaseTx   `json:"base_tx"`
+	Voter   sdk.AccAddress `json:"voter"`   //  address of the voter
+	Options string         `json:"options"` //  options with weights summing to 1, eg. Yes=0.6,No=0.4
+}
+
 func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req postProposalReq
@@ -103,12 +128,18 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 		case gov.ProposalTypeSoftwareUpgrade:
 			msgs[0] = gov.NewMsgSubmitSoftwareUpgradeProposal(msg, req.Upgrade.Version, req.Upgrade.Software, req.Upgrade.SwitchHeight, req.Upgrade.Threshold)
 			break
+		case gov.ProposalTypeUpgradeReschedule:
+			msgs[0] = gov.NewMsgSubmitUpgradeRescheduleProposal(msg, req.Reschedule.Version, req.Reschedule.Cancel, req.Reschedule.SwitchHeight, req.Reschedule.Threshold)
+			break
 		case gov.ProposalTypeCommunityTaxUsage:
 			msgs[0] = gov.NewMsgSubmitCommunityTaxUsageProposal(msg, req.CommTax.Usage, req.CommTax.DestAddress, req.CommTax.Percent)
 			break
 		case gov.ProposalTypeTokenAddition:
 			msgs[0] = gov.NewMsgSubmitTokenAdditionProposal(msg, req.Token.Symbol, req.Token.CanonicalSymbol, req.Token.Name, req.Token.MinUnitAlias, req.Token.Decimal)
 			break
+		case gov.ProposalTypeMsgs:
+			msgs[0] = gov.NewMsgSubmitMsgsProposal(msg, req.Msgs)
+			break
 		default:
 			utils.WriteErrorResponse(w, http.StatusBadRequest, "not a valid proposal type")
 			return
@@ -169,6 +200,89 @@ func depositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 	}
 }
 
+func cancelProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req cancelProposalReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgCancelProposal(req.Proposer, proposalID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func setRepresentativeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bechDelegatorAddr := vars[RestDelegator]
+
+		if len(bechDelegatorAddr) == 0 {
+			err := errors.New("delegator address required but not specified")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req setRepresentativeReq
+		err = utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgSetRepresentative(delegatorAddr, req.Representative)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -215,3 +329,50 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
 	}
 }
+
+func weightedVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req weightedVoteReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		options, err := client.NormalizeWeightedVoteOptions(req.Options)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgWeightedVote(req.Voter, proposalID, options)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"strings"
+
 	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/app/v1/auth"
 	distr "github.com/irisnet/irishub/app/v1/distribution"
@@ -47,6 +49,17 @@ func NormalizeVoteOption(option string) string {
 	return option
 }
 
+// NormalizeWeightedVoteOptions - normalize and parse user specified vote options with weights, eg. yes=0.6,no=0.4
+func NormalizeWeightedVoteOptions(options string) (gov.WeightedVoteOptions, error) {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		kv[0] = NormalizeVoteOption(kv[0])
+		pairs[i] = strings.Join(kv, "=")
+	}
+	return gov.WeightedVoteOptionsFromString(strings.Join(pairs, ","))
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -54,12 +67,16 @@ func NormalizeProposalType(proposalType string) string {
 		return "Parameter"
 	case "SoftwareUpgrade", "software_upgrade":
 		return "SoftwareUpgrade"
+	case "UpgradeReschedule", "upgrade_reschedule":
+		return "UpgradeReschedule"
 	case "SystemHalt", "system_halt":
 		return "SystemHalt"
 	case "CommunityTaxUsage", "community_tax_usage":
 		return "CommunityTaxUsage"
 	case "TokenAddition", "token_addition":
 		return "TokenAddition"
+	case "Msgs", "msgs":
+		return "Msgs"
 	}
 	return proposalType
 }
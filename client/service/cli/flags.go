@@ -23,12 +23,36 @@ const (
 	FlagServiceFee         = "service-fee"
 	FlagReqData            = "request-data"
 	FlagRespData           = "response-data"
+	FlagReqJSON            = "request-json"
+	FlagRespJSON           = "response-json"
 	FlagErrMsg             = "error-msg"
 	FlagProfiling          = "profiling"
 	FlagReqChainId         = "request-chain-id"
 	FlagReqId              = "request-id"
 	FlagDestAddress        = "dest-address"
 	FlagWithdrawAmount     = "withdraw-amount"
+	FlagProviders          = "providers"
+	FlagProviderCount      = "provider-count"
+	FlagMulticastReqId     = "multicast-request-id"
+	FlagDecryptWith        = "decrypt-with"
+	FlagMinSuccessRate     = "min-success-rate"
+	FlagPage               = "page"
+	FlagSize               = "size"
+	FlagHandlerCmd         = "handler-cmd"
+	FlagHandlerURL         = "handler-url"
+	FlagHandlerTimeout     = "handler-timeout"
+	FlagMaxRetries         = "max-retries"
+	FlagSyncInterval       = "sync-interval"
+	FlagStateFile          = "state-file"
+	FlagDefVersion         = "def-version"
+	FlagWindow             = "window"
+	FlagTiers              = "tiers"
+	FlagConsumerPrices     = "consumer-prices"
+	FlagConsumer           = "consumer"
+	FlagInterval           = "interval"
+	FlagMaxRepetitions     = "max-repetitions"
+	FlagEscrow             = "escrow"
+	FlagSubscriptionID     = "subscription-id"
 )
 
 var (
@@ -40,6 +64,12 @@ var (
 	FsServiceRequest          = flag.NewFlagSet("", flag.ContinueOnError)
 	FsServiceResponse         = flag.NewFlagSet("", flag.ContinueOnError)
 	FsServiceWithdrawTax      = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceHistory          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceMulticast        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceServe            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceVersion          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServicePricing          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsServiceSubscription     = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -71,13 +101,44 @@ func init() {
 	FsServiceRequest.Int16(FlagMethodID, 0, "the method id called")
 	FsServiceRequest.String(FlagServiceFee, "", "fee to pay for a service invocation")
 	FsServiceRequest.BytesHex(FlagReqData, nil, "hex encoded request data of a service invocation")
+	FsServiceRequest.String(FlagReqJSON, "", "json request data of a service invocation, encoded according to the request message in the idl")
 	FsServiceRequest.Bool(FlagProfiling, false, "service invocation profiling model, default false")
 
 	FsServiceResponse.BytesHex(FlagRespData, nil, "hex encoded response data of a service invocation")
+	FsServiceResponse.String(FlagRespJSON, "", "json response data of a service invocation, encoded according to the response message in the idl")
 	FsServiceResponse.BytesHex(FlagErrMsg, nil, "hex encoded response error msg of a service invocation")
 	FsServiceResponse.String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
 	FsServiceResponse.String(FlagReqId, "", "the ID of the service invocation")
 
 	FsServiceWithdrawTax.String(FlagDestAddress, "", "bech32 encoded address of the destination account")
 	FsServiceWithdrawTax.String(FlagWithdrawAmount, "", "withdraw amount")
+
+	FsServiceMulticast.String(FlagBindChainID, "", "the ID of the blockchain bond of the service")
+	FsServiceMulticast.StringSlice(FlagProviders, []string{}, "bech32 encoded accounts of the providers to be called")
+	FsServiceMulticast.Uint16(FlagProviderCount, 0, "the number of any available providers to be called, if no providers specified")
+
+	FsServiceHistory.Uint64(FlagPage, 1, "the page number of the history, starting from 1")
+	FsServiceHistory.Uint16(FlagSize, 100, "the number of entries per page, no more than 100")
+
+	FsServiceServe.String(FlagBindChainID, "", "the ID of the blockchain bond of the service")
+	FsServiceServe.String(FlagHandlerCmd, "", "local command to handle the requests, which reads the request from stdin and writes the response to stdout in json")
+	FsServiceServe.String(FlagHandlerURL, "", "http callback to handle the requests, which is posted the request and replies the response in json")
+	FsServiceServe.Int(FlagHandlerTimeout, 30, "timeout in seconds of handling a request")
+	FsServiceServe.Int(FlagMaxRetries, 3, "maximum number of attempts to respond a request")
+	FsServiceServe.Int(FlagSyncInterval, 30, "interval in seconds of polling the active requests")
+	FsServiceServe.String(FlagStateFile, "", "file to track the progress of the requests, default $HOME/service/<def-chain-id>-<service-name>-<bind-chain-id>.json")
+
+	FsServiceVersion.Uint64(FlagDefVersion, 0, "the version of the service definition, 0 for the latest version")
+
+	FsServicePricing.Int64(FlagWindow, 0, "the rolling window of the volume tiers in blocks")
+	FsServicePricing.StringSlice(FlagTiers, []string{}, "volume tiers of format <min requests>:<discount>, e.g. 100:0.1,1000:0.2")
+	FsServiceSubscription.Int16(FlagMethodID, 0, "the method id called")
+	FsServiceSubscription.String(FlagServiceFee, "", "maximal fee to pay for each service invocation")
+	FsServiceSubscription.BytesHex(FlagReqData, nil, "hex encoded request data of the service invocations")
+	FsServiceSubscription.String(FlagReqJSON, "", "json request data of the service invocations, encoded according to the request message in the idl")
+	FsServiceSubscription.Uint64(FlagInterval, 0, "number of blocks between two service invocations")
+	FsServiceSubscription.Uint64(FlagMaxRepetitions, 0, "number of service invocations")
+	FsServiceSubscription.String(FlagEscrow, "", "service fees escrowed for the service invocations, the rest is refunded when the subscription is closed")
+
+	FsServicePricing.StringSlice(FlagConsumerPrices, []string{}, "special prices of consumers of format <consumer address>=<price>[:<price>...], containing all methods")
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/service"
@@ -18,17 +19,19 @@ func GetCmdQuerySvcDef(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "definition",
 		Short:   "Query service definition",
-		Example: "iriscli service definition --def-chain-id=<chain-id> --service-name=<service name>",
+		Example: "iriscli service definition --def-chain-id=<chain-id> --service-name=<service name> --def-version=1",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
 				WithAccountDecoder(utils.GetAccountDecoder(cdc))
 
 			name := viper.GetString(FlagServiceName)
 			defChainId := viper.GetString(FlagDefChainID)
+			version := uint64(viper.GetInt64(FlagDefVersion))
 
 			params := service.QueryServiceParams{
 				DefChainID:  defChainId,
 				ServiceName: name,
+				Version:     version,
 			}
 
 			bz, err := cdc.MarshalJSON(params)
@@ -47,6 +50,42 @@ func GetCmdQuerySvcDef(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceVersion)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	return cmd
+}
+
+func GetCmdQuerySvcDefVersions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "definition-versions",
+		Short:   "Query all versions of a service definition",
+		Example: "iriscli service definition-versions --def-chain-id=<chain-id> --service-name=<service name>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			params := service.QueryServiceParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryDefinitionVersions)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
 	cmd.MarkFlagRequired(FlagDefChainID)
 	cmd.MarkFlagRequired(FlagServiceName)
 	return cmd
@@ -102,11 +141,110 @@ func GetCmdQuerySvcBind(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdQuerySvcBindingStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "binding-stats",
+		Short: "Query the service history of a service binding",
+		Example: "iriscli service binding-stats --def-chain-id=<chain-id> --service-name=<service name> " +
+			"--bind-chain-id=<chain-id> --provider=<provider>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			provider, err := sdk.AccAddressFromBech32(viper.GetString(FlagProvider))
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryBindingParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				BindChainId: viper.GetString(FlagBindChainID),
+				Provider:    provider,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryBindingStats)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	return cmd
+}
+
+func GetCmdQuerySvcEffectivePrices(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-prices",
+		Short: "Query the prices of a service binding for a consumer after applying the pricing rules",
+		Example: "iriscli service effective-prices --def-chain-id=<chain-id> --service-name=<service name> " +
+			"--bind-chain-id=<chain-id> --provider=<provider> --consumer=<consumer>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			provider, err := sdk.AccAddressFromBech32(viper.GetString(FlagProvider))
+			if err != nil {
+				return err
+			}
+			consumer, err := sdk.AccAddressFromBech32(viper.GetString(FlagConsumer))
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryEffectivePricesParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				BindChainId: viper.GetString(FlagBindChainID),
+				Provider:    provider,
+				Consumer:    consumer,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryEffectivePrices)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.Flags().String(FlagConsumer, "", "bech32 encoded account of the consumer")
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	cmd.MarkFlagRequired(FlagConsumer)
+	return cmd
+}
+
 func GetCmdQuerySvcBinds(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "bindings",
 		Short:   "Query service bindings",
-		Example: "iriscli service bindings --def-chain-id=<chain-id> --service-name=<service name>",
+		Example: "iriscli service bindings --def-chain-id=<chain-id> --service-name=<service name> --min-success-rate=0.9",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
 				WithAccountDecoder(utils.GetAccountDecoder(cdc))
@@ -114,9 +252,10 @@ func GetCmdQuerySvcBinds(cdc *codec.Codec) *cobra.Command {
 			name := viper.GetString(FlagServiceName)
 			defChainId := viper.GetString(FlagDefChainID)
 
-			params := service.QueryServiceParams{
-				DefChainID:  defChainId,
-				ServiceName: name,
+			params := service.QueryBindingsParams{
+				DefChainID:     defChainId,
+				ServiceName:    name,
+				MinSuccessRate: viper.GetString(FlagMinSuccessRate),
 			}
 
 			bz, err := cdc.MarshalJSON(params)
@@ -135,6 +274,7 @@ func GetCmdQuerySvcBinds(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().String(FlagMinSuccessRate, "", "only list the bindings whose success rate is not less than it, e.g. 0.9")
 	cmd.MarkFlagRequired(FlagDefChainID)
 	cmd.MarkFlagRequired(FlagServiceName)
 	return cmd
@@ -219,17 +359,70 @@ func GetCmdQuerySvcResponse(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fmt.Println(string(res))
+			keyName := viper.GetString(FlagDecryptWith)
+			if len(keyName) == 0 {
+				fmt.Println(string(res))
+				return nil
+			}
+
+			var response service.SvcResponse
+			if err := cdc.UnmarshalJSON(res, &response); err != nil {
+				return err
+			}
+			response, err = decryptResponse(response, keyName)
+			if err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, response)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
 			return nil
 		},
 	}
 	cmd.Flags().String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
 	cmd.Flags().String(FlagReqId, "", "the ID of the service invocation")
+	cmd.Flags().String(FlagDecryptWith, "", "name of the consumer key in the keybase to decrypt the encrypted output")
 	cmd.MarkFlagRequired(FlagReqChainId)
 	cmd.MarkFlagRequired(FlagReqId)
 	return cmd
 }
 
+func GetCmdQuerySvcMulticastRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multicast-request",
+		Short:   "Query a multicast service request with the status and responses of each provider",
+		Example: "iriscli service multicast-request --multicast-request-id=<multicast-request-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			params := service.QueryMulticastRequestParams{
+				MulticastRequestId: viper.GetString(FlagMulticastReqId),
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryMulticastRequest)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagMulticastReqId, "", "the ID of the multicast service invocation")
+	cmd.MarkFlagRequired(FlagMulticastReqId)
+	return cmd
+}
+
 func GetCmdQuerySvcFees(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "fees",
@@ -268,3 +461,128 @@ func GetCmdQuerySvcFees(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+func GetCmdQuerySvcSubscription(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subscription",
+		Short:   "Query an active subscription",
+		Example: "iriscli service subscription <subscription id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			subscriptionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(service.QuerySubscriptionParams{SubscriptionID: subscriptionID})
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QuerySubscription)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func GetCmdQuerySvcSubscriptions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subscriptions",
+		Short:   "Query the active subscriptions of a consumer",
+		Example: "iriscli service subscriptions <consumer address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			consumer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(service.QuerySubscriptionsParams{Consumer: consumer})
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QuerySubscriptions)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	return cmd
+}
+
+func GetCmdQuerySvcConsumerRequests(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consumer-requests",
+		Short:   "Query the service request history of a consumer",
+		Example: "iriscli service consumer-requests <consumer address> --page=1 --size=20",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryConsumerHistory(cdc, args[0], service.QueryConsumerRequests)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceHistory)
+	return cmd
+}
+
+func GetCmdQuerySvcConsumerResponses(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consumer-responses",
+		Short:   "Query the service response history of a consumer",
+		Example: "iriscli service consumer-responses <consumer address> --page=1 --size=20",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryConsumerHistory(cdc, args[0], service.QueryConsumerResponses)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceHistory)
+	return cmd
+}
+
+func queryConsumerHistory(cdc *codec.Codec, consumerStr, path string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+		WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+	consumer, err := sdk.AccAddressFromBech32(consumerStr)
+	if err != nil {
+		return err
+	}
+
+	pagination := sdk.NewPaginationParams(uint64(viper.GetInt64(FlagPage)), uint16(viper.GetInt(FlagSize)))
+	params := service.QueryConsumerHistoryParams{
+		Consumer: consumer,
+		Page:     pagination.Page,
+		Size:     pagination.Size,
+	}
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, path)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
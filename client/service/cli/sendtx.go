@@ -34,17 +34,9 @@ func GetCmdSvcDef(cdc *codec.Codec) *cobra.Command {
 			description := viper.GetString(FlagServiceDescription)
 			authorDescription := viper.GetString(FlagAuthorDescription)
 			tags := viper.GetStringSlice(FlagTags)
-			content := viper.GetString(FlagIdlContent)
-			if len(content) > 0 {
-				content = strings.Replace(content, `\n`, "\n", -1)
-			}
-			filePath := viper.GetString(FlagFile)
-			if len(filePath) > 0 {
-				contentBytes, err := cmn.ReadFile(filePath)
-				if err != nil {
-					return err
-				}
-				content = string(contentBytes)
+			content, err := getIdlContent()
+			if err != nil {
+				return err
 			}
 			fmt.Printf("idl condent: \n%s\n", content)
 			chainId := viper.GetString(client.FlagChainID)
@@ -54,11 +46,45 @@ func GetCmdSvcDef(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			msg := service.NewMsgSvcDef(name, chainId, description, tags, fromAddr, authorDescription, content)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinitionCreate)
+	cmd.MarkFlagRequired(FlagServiceName)
+	return cmd
+}
+
+func GetCmdSvcDefVersion(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "define-version",
+		Short: "Create a new version of a service definition",
+		Example: "iriscli service define-version --chain-id=<chain-id> --from=<key-name> --fee=0.3iris " +
+			"--service-name=<service name> --service-description=<service description> --author-description=<author description> " +
+			"--tags=tag1,tag2 --idl-content=<interface description content> --file=test.proto",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			name := viper.GetString(FlagServiceName)
+			description := viper.GetString(FlagServiceDescription)
+			authorDescription := viper.GetString(FlagAuthorDescription)
+			tags := viper.GetStringSlice(FlagTags)
+			content, err := getIdlContent()
 			if err != nil {
 				return err
 			}
+			chainId := viper.GetString(client.FlagChainID)
 
-			msg := service.NewMsgSvcDef(name, chainId, description, tags, fromAddr, authorDescription, content)
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := service.NewMsgSvcDefVersion(name, chainId, description, tags, fromAddr, authorDescription, content)
 			cliCtx.PrintResponse = true
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
@@ -68,6 +94,56 @@ func GetCmdSvcDef(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdSvcDefDeprecate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deprecate",
+		Short:   "Deprecate a version of a service definition, no new binding to it is allowed",
+		Example: "iriscli service deprecate --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --def-chain-id=<chain-id> --service-name=<service name> --def-version=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			name := viper.GetString(FlagServiceName)
+			defChainId := viper.GetString(FlagDefChainID)
+			version := uint64(viper.GetInt64(FlagDefVersion))
+
+			msg := service.NewMsgSvcDefDeprecate(defChainId, name, version, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceVersion)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagDefVersion)
+	return cmd
+}
+
+// getIdlContent reads the idl content from the flags or the file
+func getIdlContent() (string, error) {
+	content := viper.GetString(FlagIdlContent)
+	if len(content) > 0 {
+		content = strings.Replace(content, `\n`, "\n", -1)
+	}
+	filePath := viper.GetString(FlagFile)
+	if len(filePath) > 0 {
+		contentBytes, err := cmn.ReadFile(filePath)
+		if err != nil {
+			return "", err
+		}
+		content = string(contentBytes)
+	}
+	return content, nil
+}
+
 func GetCmdSvcBind(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bind",
@@ -115,13 +191,15 @@ func GetCmdSvcBind(cdc *codec.Codec) *cobra.Command {
 			}
 
 			level := service.Level{AvgRspTime: avgRspTime, UsableTime: usableTime}
-			msg := service.NewMsgSvcBind(defChainId, name, chainId, fromAddr, bindingType, deposit, prices, level)
+			defVersion := uint64(viper.GetInt64(FlagDefVersion))
+			msg := service.NewMsgSvcBind(defChainId, name, chainId, fromAddr, bindingType, deposit, prices, level, defVersion)
 			cliCtx.PrintResponse = true
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
 	}
 	cmd.Flags().AddFlagSet(FsServiceDefinition)
 	cmd.Flags().AddFlagSet(FsServiceBindingCreate)
+	cmd.Flags().AddFlagSet(FsServiceVersion)
 	cmd.MarkFlagRequired(FlagDefChainID)
 	cmd.MarkFlagRequired(FlagServiceName)
 	cmd.MarkFlagRequired(FlagBindType)
@@ -196,6 +274,45 @@ func GetCmdSvcBindUpdate(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdSvcSetPricing(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pricing",
+		Short: "Set the pricing rules of a service binding, no rules to remove the existing ones",
+		Example: "iriscli service set-pricing --chain-id=<chain-id> --from=<key-name> --fee=0.3iris " +
+			"--service-name=<service name> --def-chain-id=<chain-id> --window=10000 --tiers=100:0.1,1000:0.2 " +
+			"--consumer-prices=<consumer address>=0.5iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(client.FlagChainID)
+			name := viper.GetString(FlagServiceName)
+			defChainId := viper.GetString(FlagDefChainID)
+
+			pricing, err := parsePricing(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := service.NewMsgSvcSetPricing(defChainId, name, chainId, fromAddr, pricing)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServicePricing)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	return cmd
+}
+
 func GetCmdSvcDisable(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "disable",
@@ -338,8 +455,12 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			inputString := viper.GetString(FlagReqData)
-			input, err := hex.DecodeString(inputString)
+			version, err := requestVersion(cliCtx, cdc, defChainId, name, bindChainId, provider)
+			if err != nil {
+				return err
+			}
+
+			input, err := buildInput(cliCtx, cdc, defChainId, name, version, methodId)
 			if err != nil {
 				return err
 			}
@@ -362,6 +483,68 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdSvcMulticast(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multicast",
+		Short: "Call a service method of multiple providers",
+		Example: "iriscli service multicast --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --def-chain-id=<bind-chain-id> " +
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --providers=<provider1>,<provider2> --service-fee=1iris --request-data=<req>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(client.FlagChainID)
+
+			defChainId := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainId := viper.GetString(FlagBindChainID)
+			methodId := int16(viper.GetInt(FlagMethodID))
+			providerCount := uint16(viper.GetInt(FlagProviderCount))
+
+			var providers []sdk.AccAddress
+			for _, providerStr := range viper.GetStringSlice(FlagProviders) {
+				provider, err := sdk.AccAddressFromBech32(providerStr)
+				if err != nil {
+					return err
+				}
+				providers = append(providers, provider)
+			}
+
+			serviceFeeStr := viper.GetString(FlagServiceFee)
+			serviceFee, err := cliCtx.ParseCoins(serviceFeeStr)
+			if err != nil {
+				return err
+			}
+
+			input, err := buildInput(cliCtx, cdc, defChainId, name, 0, methodId)
+			if err != nil {
+				return err
+			}
+
+			profiling := viper.GetBool(FlagProfiling)
+
+			msg := service.NewMsgSvcMulticastRequest(defChainId, name, bindChainId, chainId, fromAddr, providers, providerCount, methodId, input, serviceFee, profiling)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceMulticast)
+	cmd.Flags().AddFlagSet(FsServiceRequest)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagMethodID)
+	return cmd
+}
+
 func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "respond",
@@ -380,11 +563,6 @@ func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 			}
 
 			reqChainId := viper.GetString(FlagReqChainId)
-			outputString := viper.GetString(FlagRespData)
-			output, err := hex.DecodeString(outputString)
-			if err != nil {
-				return err
-			}
 
 			errMsgString := viper.GetString(FlagErrMsg)
 			errMsg, err := hex.DecodeString(errMsgString)
@@ -394,6 +572,11 @@ func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 
 			reqId := viper.GetString(FlagReqId)
 
+			output, err := buildOutput(cliCtx, cdc, reqId)
+			if err != nil {
+				return err
+			}
+
 			msg := service.NewMsgSvcResponse(reqChainId, reqId, fromAddr, output, errMsg)
 			cliCtx.PrintResponse = true
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
@@ -405,6 +588,134 @@ func GetCmdSvcRespond(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdSvcCancel(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel",
+		Short:   "Cancel a service method invocation which has not been responded",
+		Example: "iriscli service cancel --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --request-id=<request-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			reqId := viper.GetString(FlagReqId)
+
+			msg := service.NewMsgSvcCancelRequest(reqId, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagReqId, "", "the ID of the service invocation")
+	cmd.MarkFlagRequired(FlagReqId)
+	return cmd
+}
+
+func GetCmdSvcSubscribe(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "Subscribe a service method, which is called every interval blocks with the service fees paid from the escrow",
+		Example: "iriscli service subscribe --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --def-chain-id=<def-chain-id> " +
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --provider=<provider> --service-fee=1iris " +
+			"--request-data=<req> --interval=100 --max-repetitions=10 --escrow=10iris",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(client.FlagChainID)
+
+			defChainId := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainId := viper.GetString(FlagBindChainID)
+			methodId := int16(viper.GetInt(FlagMethodID))
+
+			provider, err := sdk.AccAddressFromBech32(viper.GetString(FlagProvider))
+			if err != nil {
+				return err
+			}
+
+			serviceFee, err := cliCtx.ParseCoins(viper.GetString(FlagServiceFee))
+			if err != nil {
+				return err
+			}
+			escrow, err := cliCtx.ParseCoins(viper.GetString(FlagEscrow))
+			if err != nil {
+				return err
+			}
+
+			version, err := requestVersion(cliCtx, cdc, defChainId, name, bindChainId, provider)
+			if err != nil {
+				return err
+			}
+
+			input, err := buildInput(cliCtx, cdc, defChainId, name, version, methodId)
+			if err != nil {
+				return err
+			}
+
+			interval := uint64(viper.GetInt64(FlagInterval))
+			maxRepetitions := uint64(viper.GetInt64(FlagMaxRepetitions))
+
+			msg := service.NewMsgSvcSubscribe(defChainId, name, bindChainId, chainId, fromAddr, provider, methodId, input,
+				serviceFee, interval, maxRepetitions, escrow)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.Flags().AddFlagSet(FsServiceSubscription)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	cmd.MarkFlagRequired(FlagMethodID)
+	cmd.MarkFlagRequired(FlagInterval)
+	cmd.MarkFlagRequired(FlagMaxRepetitions)
+	cmd.MarkFlagRequired(FlagEscrow)
+	return cmd
+}
+
+func GetCmdSvcCancelSubscription(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-subscription",
+		Short:   "Cancel a subscription and refund the escrow left",
+		Example: "iriscli service cancel-subscription --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --subscription-id=<subscription-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			subscriptionID := uint64(viper.GetInt64(FlagSubscriptionID))
+
+			msg := service.NewMsgSvcCancelSubscription(subscriptionID, fromAddr)
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint64(FlagSubscriptionID, 0, "the ID of the subscription")
+	cmd.MarkFlagRequired(FlagSubscriptionID)
+	return cmd
+}
+
 func GetCmdSvcRefundFees(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "refund-fees",
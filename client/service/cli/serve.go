@@ -0,0 +1,552 @@
+package cli
+
+import (
+	"bytes"
+	gocontext "context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/irisnet/irishub/app/v1/service"
+	"github.com/irisnet/irishub/app/v1/service/tags"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/keys"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// status of a request tracked by the provider daemon
+const (
+	serveStatusBroadcast = "Broadcast" // the response has been broadcast
+	serveStatusFailed    = "Failed"    // gave up after the maximum number of attempts
+)
+
+// the number of blocks to wait for a broadcast response to be committed before retrying
+const rebroadcastBlocks = 5
+
+// serveRequest is the request passed to the handler
+type serveRequest struct {
+	RequestID  string         `json:"request_id"`
+	DefChainID string         `json:"def_chain_id"`
+	DefName    string         `json:"def_name"`
+	MethodID   int16          `json:"method_id"`
+	Consumer   sdk.AccAddress `json:"consumer"`
+	Input      string         `json:"input"` // hex encoded
+	ServiceFee sdk.Coins      `json:"service_fee"`
+	Profiling  bool           `json:"profiling"`
+}
+
+// serveResponse is the response replied by the handler
+type serveResponse struct {
+	Output     string          `json:"output"`      // hex encoded
+	OutputJSON json.RawMessage `json:"output_json"` // json output encoded according to the idl, instead of output
+	ErrorMsg   string          `json:"error_msg"`
+}
+
+// requestHandler handles the requests dispatched by the provider daemon
+type requestHandler interface {
+	handle(request serveRequest) (serveResponse, error)
+}
+
+// execHandler runs a local command for each request, the request is written to its stdin
+// and the response is read from its stdout
+type execHandler struct {
+	args    []string
+	timeout time.Duration
+}
+
+func (h execHandler) handle(request serveRequest) (response serveResponse, err error) {
+	bz, err := json.Marshal(request)
+	if err != nil {
+		return response, err
+	}
+
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), h.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.args[0], h.args[1:]...)
+	cmd.Stdin = bytes.NewReader(bz)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return response, fmt.Errorf("%s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	err = json.Unmarshal(stdout.Bytes(), &response)
+	return response, err
+}
+
+// httpHandler posts each request to the callback url and reads the response from the reply
+type httpHandler struct {
+	url    string
+	client *http.Client
+}
+
+func (h httpHandler) handle(request serveRequest) (response serveResponse, err error) {
+	bz, err := json.Marshal(request)
+	if err != nil {
+		return response, err
+	}
+
+	res, err := h.client.Post(h.url, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return response, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return response, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return response, fmt.Errorf("handler replied %s: %s", res.Status, strings.TrimSpace(string(body)))
+	}
+
+	err = json.Unmarshal(body, &response)
+	return response, err
+}
+
+// serveRecord tracks the progress of a request
+type serveRecord struct {
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	Height    int64  `json:"height"` // the block height of the last attempt
+	LastError string `json:"last_error,omitempty"`
+}
+
+// serveState is the progress of the requests persisted in the state file
+type serveState struct {
+	file    string
+	Records map[string]serveRecord `json:"records"`
+}
+
+func loadServeState(file string) (*serveState, error) {
+	state := &serveState{file: file, Records: make(map[string]serveRecord)}
+	bz, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return state, os.MkdirAll(filepath.Dir(file), 0700)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, fmt.Errorf("failed to load the state file %s: %s", file, err)
+	}
+	return state, nil
+}
+
+// save writes the state to a temporary file and renames it, so that the state file is never partially written
+func (s *serveState) save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
+
+// prune removes the records of the expired requests
+func (s *serveState) prune(height int64) {
+	for reqId := range s.Records {
+		eHeight, _, _, err := service.ConvertRequestID(reqId)
+		if err != nil || eHeight <= height {
+			delete(s.Records, reqId)
+		}
+	}
+}
+
+// shouldServe checks whether the request should be attempted at the height. A broadcast response is waited
+// for to be committed, a failed attempt is backed off, and the request is given up after the maximum number
+// of attempts, no matter whether the last attempt was broadcast or failed
+func (s *serveState) shouldServe(reqId string, height int64, maxRetries int) bool {
+	record := s.Records[reqId]
+	switch {
+	case record.Status == serveStatusFailed:
+		return false
+	case record.Status == serveStatusBroadcast && height-record.Height < rebroadcastBlocks:
+		return false
+	case record.Attempts > 0 && height < record.Height+int64(record.Attempts):
+		// back off for more blocks after each failed attempt
+		return false
+	case record.Attempts >= maxRetries:
+		record.Status = serveStatusFailed
+		s.Records[reqId] = record
+		return false
+	}
+	return true
+}
+
+// recordAttempt records an attempt to respond the request, either broadcast or failed with the error
+func (s *serveState) recordAttempt(reqId string, height int64, err error, maxRetries int) serveRecord {
+	record := s.Records[reqId]
+	record.Attempts++
+	record.Height = height
+	switch {
+	case err == nil:
+		record.Status = serveStatusBroadcast
+		record.LastError = ""
+	case record.Attempts >= maxRetries:
+		record.Status = serveStatusFailed
+		record.LastError = err.Error()
+	default:
+		record.Status = ""
+		record.LastError = err.Error()
+	}
+	s.Records[reqId] = record
+	return record
+}
+
+// provider daemon serving the requests of a service binding
+type serveDaemon struct {
+	cliCtx      context.CLIContext
+	txCtx       utils.TxContext
+	cdc         *codec.Codec
+	logger      log.Logger
+	handler     requestHandler
+	state       *serveState
+	maxRetries  int
+	defChainID  string
+	defName     string
+	bindChainID string
+	provider    sdk.AccAddress
+	keyName     string
+	passphrase  string
+}
+
+// latestHeight returns the latest block height of the node
+func (d *serveDaemon) latestHeight() (int64, error) {
+	node, err := d.cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// sync polls the active requests of the binding, to catch the requests missed by the subscription
+func (d *serveDaemon) sync() {
+	var requests []service.SvcRequest
+	params := service.QueryBindingParams{
+		DefChainID:  d.defChainID,
+		ServiceName: d.defName,
+		BindChainId: d.bindChainID,
+		Provider:    d.provider,
+	}
+	if err := queryWithParams(d.cliCtx, d.cdc, service.QueryRequests, params, &requests); err != nil {
+		d.logger.Error("failed to query the active requests", "err", err)
+		return
+	}
+
+	height, err := d.latestHeight()
+	if err != nil {
+		d.logger.Error("failed to query the latest height", "err", err)
+		return
+	}
+	d.state.prune(height)
+
+	for _, request := range requests {
+		d.serve(request, height)
+	}
+	d.saveState()
+}
+
+// process serves the request of the given id received from the subscription
+func (d *serveDaemon) process(reqId string) {
+	var request service.SvcRequest
+	if err := queryWithParams(d.cliCtx, d.cdc, service.QueryRequest, service.QueryRequestParams{RequestId: reqId}, &request); err != nil {
+		d.logger.Debug("request is not active", "request_id", reqId, "err", err)
+		return
+	}
+	if !request.Provider.Equals(d.provider) || request.DefChainID != d.defChainID ||
+		request.DefName != d.defName || request.BindChainID != d.bindChainID {
+		return
+	}
+
+	height, err := d.latestHeight()
+	if err != nil {
+		d.logger.Error("failed to query the latest height", "err", err)
+		return
+	}
+	d.serve(request, height)
+	d.saveState()
+}
+
+// serve dispatches the request to the handler and broadcasts the response,
+// unless the request is expired, being committed or attempted too many times
+func (d *serveDaemon) serve(request service.SvcRequest, height int64) {
+	reqId := request.RequestID()
+	if request.ExpirationHeight <= height || !d.state.shouldServe(reqId, height, d.maxRetries) {
+		return
+	}
+
+	err := d.respond(request)
+	record := d.state.recordAttempt(reqId, height, err, d.maxRetries)
+	if err != nil {
+		d.logger.Error("failed to respond the request", "request_id", reqId, "attempts", record.Attempts, "err", err)
+	} else {
+		d.logger.Info("responded the request", "request_id", reqId, "attempts", record.Attempts)
+	}
+}
+
+func (d *serveDaemon) respond(request service.SvcRequest) error {
+	response, err := d.handler.handle(serveRequest{
+		RequestID:  request.RequestID(),
+		DefChainID: request.DefChainID,
+		DefName:    request.DefName,
+		MethodID:   request.MethodID,
+		Consumer:   request.Consumer,
+		Input:      hex.EncodeToString(request.Input),
+		ServiceFee: request.ServiceFee,
+		Profiling:  request.Profiling,
+	})
+	if err != nil {
+		return err
+	}
+
+	output, err := hex.DecodeString(response.Output)
+	if err != nil {
+		return err
+	}
+	if len(output) > 0 && len(response.OutputJSON) > 0 {
+		return fmt.Errorf("only one of output and output_json can be replied")
+	}
+	output, err = prepareOutput(d.cliCtx, d.cdc, request, output, string(response.OutputJSON))
+	if err != nil {
+		return err
+	}
+
+	msg := service.NewMsgSvcResponse(request.ReqChainID, request.RequestID(), d.provider, output, []byte(response.ErrorMsg))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return d.broadcast(msg)
+}
+
+// broadcast signs the message and broadcasts it, the sequence is tracked locally
+// as the responses may be broadcast before the previous ones are committed
+func (d *serveDaemon) broadcast(msg sdk.Msg) error {
+	if d.txCtx.Sequence == 0 {
+		sequence, err := d.cliCtx.GetAccountSequence(d.provider)
+		if err != nil {
+			return err
+		}
+		d.txCtx = d.txCtx.WithSequence(sequence)
+	}
+
+	txBytes, err := d.txCtx.BuildAndSign(d.keyName, d.passphrase, []sdk.Msg{msg})
+	if err != nil {
+		return err
+	}
+	res, err := d.cliCtx.BroadcastTxSync(txBytes)
+	if err == nil && !res.CheckTx.IsOK() {
+		err = errors.New(res.CheckTx.Log)
+	}
+	if err != nil {
+		// query the sequence again in case it is out of sync
+		d.txCtx = d.txCtx.WithSequence(0)
+		return err
+	}
+
+	d.txCtx = d.txCtx.WithSequence(d.txCtx.Sequence + 1)
+	return nil
+}
+
+func (d *serveDaemon) saveState() {
+	if err := d.state.save(); err != nil {
+		d.logger.Error("failed to save the state", "file", d.state.file, "err", err)
+	}
+}
+
+// subscribe subscribes to the txs tagged with the provider, and returns the channel of the events
+func (d *serveDaemon) subscribe() (<-chan interface{}, error) {
+	client := rpcclient.NewHTTP(d.cliCtx.NodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return nil, err
+	}
+
+	events := make(chan interface{}, 100)
+	q := query.MustParse(fmt.Sprintf("%s='%s' AND %s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx, tags.Provider, d.provider))
+	if err := client.Subscribe(gocontext.Background(), "iriscli-service-serve", q, events); err != nil {
+		client.Stop()
+		return nil, err
+	}
+	return events, nil
+}
+
+// requestIDs returns the ids of the requests in the tx event
+func requestIDs(event interface{}) (reqIds []string) {
+	tx, ok := event.(tmtypes.EventDataTx)
+	if !ok {
+		return nil
+	}
+	for _, tag := range tx.Result.Tags {
+		if string(tag.Key) == tags.RequestID {
+			reqIds = append(reqIds, string(tag.Value))
+		}
+	}
+	return reqIds
+}
+
+func (d *serveDaemon) run(syncInterval time.Duration) error {
+	events, err := d.subscribe()
+	if err != nil {
+		// keep serving by polling
+		d.logger.Error("failed to subscribe to the requests, polling only", "err", err)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+
+	d.sync()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				d.logger.Error("the subscription is closed, polling only")
+				events = nil
+				continue
+			}
+			for _, reqId := range requestIDs(event) {
+				d.process(reqId)
+			}
+		case <-ticker.C:
+			d.sync()
+		case <-sigs:
+			d.saveState()
+			return nil
+		}
+	}
+}
+
+func GetCmdSvcServe(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the requests of a service binding with a local handler",
+		Long: "Run a provider daemon which dispatches the requests of the service binding to a local command or an http callback, " +
+			"then signs and broadcasts the responses with the key of the provider. The handler gets the request in json " +
+			"{request_id, def_chain_id, def_name, method_id, consumer, input, service_fee, profiling} and replies the response in json " +
+			"{output, output_json, error_msg}, where input and output are hex encoded",
+		Example: "iriscli service serve --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --def-chain-id=<def-chain-id> " +
+			"--service-name=<service name> --bind-chain-id=<bind-chain-id> --handler-cmd=\"/path/to/handler arg\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			provider, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			keyName, err := cliCtx.GetFromName()
+			if err != nil {
+				return err
+			}
+
+			defChainId := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainId := viper.GetString(FlagBindChainID)
+
+			var handler requestHandler
+			timeout := time.Duration(viper.GetInt(FlagHandlerTimeout)) * time.Second
+			handlerCmd := strings.Fields(viper.GetString(FlagHandlerCmd))
+			handlerURL := viper.GetString(FlagHandlerURL)
+			switch {
+			case len(handlerCmd) > 0 && len(handlerURL) > 0:
+				return fmt.Errorf("only one of --%s and --%s can be specified", FlagHandlerCmd, FlagHandlerURL)
+			case len(handlerCmd) > 0:
+				handler = execHandler{args: handlerCmd, timeout: timeout}
+			case len(handlerURL) > 0:
+				handler = httpHandler{url: handlerURL, client: &http.Client{Timeout: timeout}}
+			default:
+				return fmt.Errorf("one of --%s and --%s is required", FlagHandlerCmd, FlagHandlerURL)
+			}
+
+			maxRetries := viper.GetInt(FlagMaxRetries)
+			if maxRetries <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagMaxRetries)
+			}
+			syncInterval := viper.GetInt(FlagSyncInterval)
+			if syncInterval <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagSyncInterval)
+			}
+
+			stateFile := viper.GetString(FlagStateFile)
+			if len(stateFile) == 0 {
+				stateFile = filepath.Join(viper.GetString(cli.HomeFlag), "service",
+					fmt.Sprintf("%s-%s-%s.json", defChainId, name, bindChainId))
+			}
+			state, err := loadServeState(stateFile)
+			if err != nil {
+				return err
+			}
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+			if txCtx.AccountNumber == 0 {
+				accNum, err := cliCtx.GetAccountNumber(provider)
+				if err != nil {
+					return err
+				}
+				txCtx = txCtx.WithAccountNumber(accNum)
+			}
+
+			passphrase, err := keys.GetPassphrase(keyName)
+			if err != nil {
+				return err
+			}
+
+			daemon := &serveDaemon{
+				cliCtx:      cliCtx,
+				txCtx:       txCtx,
+				cdc:         cdc,
+				logger:      log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "service-serve"),
+				handler:     handler,
+				state:       state,
+				maxRetries:  maxRetries,
+				defChainID:  defChainId,
+				defName:     name,
+				bindChainID: bindChainId,
+				provider:    provider,
+				keyName:     keyName,
+				passphrase:  passphrase,
+			}
+			return daemon.run(time.Duration(syncInterval) * time.Second)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceServe)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	return cmd
+}
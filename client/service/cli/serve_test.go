@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeStatePrune(t *testing.T) {
+	state := &serveState{Records: map[string]serveRecord{
+		"10-1-0":  {Status: serveStatusBroadcast},
+		"20-1-1":  {Status: serveStatusFailed},
+		"invalid": {},
+	}}
+
+	state.prune(10)
+	require.Len(t, state.Records, 1)
+	require.Contains(t, state.Records, "20-1-1")
+
+	state.prune(20)
+	require.Empty(t, state.Records)
+}
+
+func TestServeStateBackoff(t *testing.T) {
+	state := &serveState{Records: make(map[string]serveRecord)}
+	reqId := "100-1-0"
+	maxRetries := 3
+
+	require.True(t, state.shouldServe(reqId, 10, maxRetries))
+	record := state.recordAttempt(reqId, 10, errors.New("handler failed"), maxRetries)
+	require.Equal(t, 1, record.Attempts)
+	require.Equal(t, "", record.Status)
+	require.Equal(t, "handler failed", record.LastError)
+
+	// back off for one block after the first failed attempt
+	require.False(t, state.shouldServe(reqId, 10, maxRetries))
+	require.True(t, state.shouldServe(reqId, 11, maxRetries))
+	state.recordAttempt(reqId, 11, errors.New("handler failed"), maxRetries)
+
+	// back off for two blocks after the second failed attempt
+	require.False(t, state.shouldServe(reqId, 12, maxRetries))
+	require.True(t, state.shouldServe(reqId, 13, maxRetries))
+
+	// give up after the maximum number of attempts
+	record = state.recordAttempt(reqId, 13, errors.New("handler failed"), maxRetries)
+	require.Equal(t, serveStatusFailed, record.Status)
+	require.False(t, state.shouldServe(reqId, 100, maxRetries))
+}
+
+func TestServeStateRebroadcast(t *testing.T) {
+	state := &serveState{Records: make(map[string]serveRecord)}
+	reqId := "100-1-0"
+	maxRetries := 2
+
+	record := state.recordAttempt(reqId, 10, nil, maxRetries)
+	require.Equal(t, serveStatusBroadcast, record.Status)
+
+	// wait for the broadcast response to be committed before rebroadcasting
+	require.False(t, state.shouldServe(reqId, 10+rebroadcastBlocks-1, maxRetries))
+	require.True(t, state.shouldServe(reqId, 10+rebroadcastBlocks, maxRetries))
+	state.recordAttempt(reqId, 10+rebroadcastBlocks, nil, maxRetries)
+
+	// a response broadcast but never committed is given up after the maximum number of attempts
+	require.False(t, state.shouldServe(reqId, 10+2*rebroadcastBlocks, maxRetries))
+	require.Equal(t, serveStatusFailed, state.Records[reqId].Status)
+	require.Equal(t, maxRetries, state.Records[reqId].Attempts)
+}
+
+func TestServeStateDedup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "serve")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "service", "state.json")
+	state, err := loadServeState(file)
+	require.NoError(t, err)
+	reqId := "100-1-0"
+	maxRetries := 3
+
+	// the request received from both the subscription and the polling is only responded once
+	require.True(t, state.shouldServe(reqId, 10, maxRetries))
+	state.recordAttempt(reqId, 10, nil, maxRetries)
+	require.False(t, state.shouldServe(reqId, 10, maxRetries))
+	require.False(t, state.shouldServe(reqId, 11, maxRetries))
+
+	// the progress is kept after restart
+	require.NoError(t, state.save())
+	state, err = loadServeState(file)
+	require.NoError(t, err)
+	require.Equal(t, serveRecord{Status: serveStatusBroadcast, Attempts: 1, Height: 10}, state.Records[reqId])
+	require.False(t, state.shouldServe(reqId, 11, maxRetries))
+}
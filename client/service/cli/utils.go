@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/irisnet/irishub/app/protocol"
+	"github.com/irisnet/irishub/app/v1/service"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/keys"
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/viper"
+)
+
+// getMethod queries the method property of the given version of the service definition, 0 for the latest version
+func getMethod(cliCtx context.CLIContext, cdc *codec.Codec, defChainID, defName string, version uint64, methodID int16) (service.MethodProperty, error) {
+	var definition service.DefinitionOutput
+	params := service.QueryServiceParams{DefChainID: defChainID, ServiceName: defName, Version: version}
+	if err := queryWithParams(cliCtx, cdc, service.QueryDefinition, params, &definition); err != nil {
+		return service.MethodProperty{}, err
+	}
+
+	for _, method := range definition.Methods {
+		if method.ID == methodID {
+			return method, nil
+		}
+	}
+	return service.MethodProperty{}, fmt.Errorf("method %d is not existed in service %s", methodID, defName)
+}
+
+// pinnedVersion returns the version of the service definition pinned by a binding or request,
+// the ones before versioning are of version 1
+func pinnedVersion(version uint64) uint64 {
+	if version == 0 {
+		return 1
+	}
+	return version
+}
+
+// buildInput returns the hex encoded input, or the json input encoded according to the request message
+// in the given version of the idl, 0 for the latest version
+func buildInput(cliCtx context.CLIContext, cdc *codec.Codec, defChainID, defName string, version uint64, methodID int16) ([]byte, error) {
+	inputJSON := viper.GetString(FlagReqJSON)
+	if len(inputJSON) == 0 {
+		return hex.DecodeString(viper.GetString(FlagReqData))
+	}
+	if len(viper.GetString(FlagReqData)) > 0 {
+		return nil, fmt.Errorf("only one of --%s and --%s can be specified", FlagReqData, FlagReqJSON)
+	}
+
+	method, err := getMethod(cliCtx, cdc, defChainID, defName, version, methodID)
+	if err != nil {
+		return nil, err
+	}
+	return method.RequestDescriptor.EncodeJSON([]byte(inputJSON))
+}
+
+// buildOutput returns the hex encoded output, or the json output encoded according to the response message in the idl
+func buildOutput(cliCtx context.CLIContext, cdc *codec.Codec, reqId string) ([]byte, error) {
+	outputJSON := viper.GetString(FlagRespJSON)
+	output, err := hex.DecodeString(viper.GetString(FlagRespData))
+	if err != nil {
+		return nil, err
+	}
+	if len(outputJSON) > 0 && len(output) > 0 {
+		return nil, fmt.Errorf("only one of --%s and --%s can be specified", FlagRespData, FlagRespJSON)
+	}
+	if len(output) == 0 && len(outputJSON) == 0 {
+		return output, nil
+	}
+
+	var request service.SvcRequest
+	if err := queryWithParams(cliCtx, cdc, service.QueryRequest, service.QueryRequestParams{RequestId: reqId}, &request); err != nil {
+		return nil, err
+	}
+	return prepareOutput(cliCtx, cdc, request, output, outputJSON)
+}
+
+// prepareOutput encodes the json output if given, validates the output against the idl, and encrypts it
+// to the public key of the consumer if the output privacy of the requested method is PubKeyEncryption
+func prepareOutput(cliCtx context.CLIContext, cdc *codec.Codec, request service.SvcRequest, output []byte, outputJSON string) ([]byte, error) {
+	if len(output) == 0 && len(outputJSON) == 0 {
+		return output, nil
+	}
+
+	method, err := getMethod(cliCtx, cdc, request.DefChainID, request.DefName, pinnedVersion(request.DefVersion), request.MethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outputJSON) > 0 {
+		if output, err = method.ResponseDescriptor.EncodeJSON([]byte(outputJSON)); err != nil {
+			return nil, err
+		}
+	} else if err = method.ResponseDescriptor.Validate(output); err != nil {
+		return nil, err
+	}
+
+	if method.OutputPrivacy != service.PubKeyEncryption {
+		return output, nil
+	}
+
+	account, err := cliCtx.GetAccount(request.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	if account.PubKey == nil {
+		return nil, fmt.Errorf("the public key of the consumer %s is unknown", request.Consumer)
+	}
+	return service.EncryptOutput(account.PubKey, output)
+}
+
+// decryptResponse decrypts the output of the response with the given key in the keybase
+func decryptResponse(response service.SvcResponse, keyName string) (service.SvcResponse, error) {
+	if len(response.Encryption) == 0 || len(response.Output) == 0 {
+		return response, nil
+	}
+	if response.Encryption != service.EncryptionSchemeECIES {
+		return response, fmt.Errorf("unsupported encryption scheme %s", response.Encryption)
+	}
+
+	keybase, err := keys.GetKeyBase()
+	if err != nil {
+		return response, err
+	}
+	passphrase, err := keys.GetPassphrase(keyName)
+	if err != nil {
+		return response, err
+	}
+	privKey, err := keybase.ExportPrivateKeyObject(keyName, passphrase)
+	if err != nil {
+		return response, err
+	}
+
+	output, err := service.DecryptOutput(privKey, response.Output)
+	if err != nil {
+		return response, err
+	}
+	response.Output = output
+	response.Encryption = ""
+	return response, nil
+}
+
+func queryWithParams(cliCtx context.CLIContext, cdc *codec.Codec, path string, params interface{}, result interface{}) error {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, path)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(res, result)
+}
+
+// requestVersion returns the version pinned by the binding if the input is given in json, otherwise 0
+func requestVersion(cliCtx context.CLIContext, cdc *codec.Codec, defChainID, defName, bindChainID string, provider sdk.AccAddress) (uint64, error) {
+	if len(viper.GetString(FlagReqJSON)) == 0 {
+		return 0, nil
+	}
+	var binding service.SvcBinding
+	params := service.QueryBindingParams{DefChainID: defChainID, ServiceName: defName, BindChainId: bindChainID, Provider: provider}
+	if err := queryWithParams(cliCtx, cdc, service.QueryBinding, params, &binding); err != nil {
+		return 0, err
+	}
+	return pinnedVersion(binding.DefVersion), nil
+}
+
+// parsePricing builds the pricing rules of a binding from the flags
+func parsePricing(cliCtx context.CLIContext) (pricing service.Pricing, err error) {
+	pricing.Window = viper.GetInt64(FlagWindow)
+
+	for _, t := range viper.GetStringSlice(FlagTiers) {
+		parts := strings.Split(t, ":")
+		if len(parts) != 2 {
+			return pricing, fmt.Errorf("invalid volume tier %s, expected <min requests>:<discount>", t)
+		}
+		minRequests, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return pricing, fmt.Errorf("invalid min requests of volume tier %s: %s", t, err)
+		}
+		discount, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return pricing, fmt.Errorf("invalid discount of volume tier %s: %s", t, err)
+		}
+		pricing.Tiers = append(pricing.Tiers, service.VolumeTier{MinRequests: minRequests, Discount: discount})
+	}
+
+	for _, c := range viper.GetStringSlice(FlagConsumerPrices) {
+		parts := strings.Split(c, "=")
+		if len(parts) != 2 {
+			return pricing, fmt.Errorf("invalid consumer prices %s, expected <consumer address>=<price>[:<price>...]", c)
+		}
+		consumer, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return pricing, err
+		}
+		var prices []sdk.Coin
+		for _, p := range strings.Split(parts[1], ":") {
+			price, err := cliCtx.ParseCoin(p)
+			if err != nil {
+				return pricing, err
+			}
+			prices = append(prices, price)
+		}
+		pricing.Consumers = append(pricing.Consumers, service.ConsumerPrice{Consumer: consumer, Prices: prices})
+	}
+	return pricing, nil
+}
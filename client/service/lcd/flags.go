@@ -1,12 +1,15 @@
 package lcd
 
 const (
-	DefChainId  = "defChainId"
-	BindChainId = "bindChainId"
-	ReqChainId  = "reqChainId"
-	ReqId       = "reqId"
-	ServiceName = "serviceName"
-	Provider    = "provider"
-	Consumer    = "consumer"
-	Address     = "address"
+	DefChainId     = "defChainId"
+	BindChainId    = "bindChainId"
+	ReqChainId     = "reqChainId"
+	ReqId          = "reqId"
+	MulticastReqId = "multicastReqId"
+	SubscriptionId = "subscriptionId"
+	ServiceName    = "serviceName"
+	DefVersion     = "defVersion"
+	Provider       = "provider"
+	Consumer       = "consumer"
+	Address        = "address"
 )
@@ -3,6 +3,7 @@ package lcd
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/irisnet/irishub/app/protocol"
@@ -21,6 +22,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		definitionGetHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// get all versions of a definition
+	r.HandleFunc(
+		fmt.Sprintf("/service/definitions/{%s}/{%s}/versions", DefChainId, ServiceName),
+		definitionVersionsGetHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// get a single binding info
 	r.HandleFunc(
 		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/{%s}", DefChainId, ServiceName, BindChainId, Provider),
@@ -28,6 +35,17 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	).Methods("GET")
 
 	// get all bindings of a definition
+	r.HandleFunc(
+		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/{%s}/prices/{%s}", DefChainId, ServiceName, BindChainId, Provider, Consumer),
+		effectivePricesHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// get the service history of a binding
+	r.HandleFunc(
+		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/{%s}/stats", DefChainId, ServiceName, BindChainId, Provider),
+		bindingStatsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	r.HandleFunc(
 		fmt.Sprintf("/service/bindings/{%s}/{%s}", DefChainId, ServiceName),
 		bindingsHandlerFn(cliCtx, cdc),
@@ -45,6 +63,36 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		responseGetHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// get a multicast request with the status and responses of each provider
+	r.HandleFunc(
+		fmt.Sprintf("/service/multicast-requests/{%s}", MulticastReqId),
+		multicastRequestGetHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// get an active subscription
+	r.HandleFunc(
+		fmt.Sprintf("/service/subscriptions/{%s}", SubscriptionId),
+		subscriptionGetHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// get the active subscriptions of a consumer
+	r.HandleFunc(
+		fmt.Sprintf("/service/consumers/{%s}/subscriptions", Consumer),
+		consumerSubscriptionsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// get the request history of a consumer
+	r.HandleFunc(
+		fmt.Sprintf("/service/consumers/{%s}/requests", Consumer),
+		consumerHistoryHandlerFn(cliCtx, cdc, service.QueryConsumerRequests),
+	).Methods("GET")
+
+	// get the response history of a consumer
+	r.HandleFunc(
+		fmt.Sprintf("/service/consumers/{%s}/responses", Consumer),
+		consumerHistoryHandlerFn(cliCtx, cdc, service.QueryConsumerResponses),
+	).Methods("GET")
+
 	// get return fee and incoming fee of a account
 	r.HandleFunc(
 		fmt.Sprintf("/service/fees/{%s}", Address),
@@ -58,9 +106,18 @@ func definitionGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Ha
 		defChainId := vars[DefChainId]
 		serviceName := vars[ServiceName]
 
+		var version uint64
+		if versionStr := r.FormValue("version"); len(versionStr) > 0 {
+			var ok bool
+			if version, ok = utils.ParseUint64OrReturnBadRequest(w, versionStr); !ok {
+				return
+			}
+		}
+
 		params := service.QueryServiceParams{
 			DefChainID:  defChainId,
 			ServiceName: serviceName,
+			Version:     version,
 		}
 
 		bz, err := cdc.MarshalJSON(params)
@@ -80,6 +137,31 @@ func definitionGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Ha
 	}
 }
 
+func definitionVersionsGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		params := service.QueryServiceParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryDefinitionVersions)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -117,15 +199,88 @@ func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerF
 	}
 }
 
+func bindingStatsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		provider, err := sdk.AccAddressFromBech32(vars[Provider])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := service.QueryBindingParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+			BindChainId: vars[BindChainId],
+			Provider:    provider,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryBindingStats)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func effectivePricesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		provider, err := sdk.AccAddressFromBech32(vars[Provider])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		consumer, err := sdk.AccAddressFromBech32(vars[Consumer])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := service.QueryEffectivePricesParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+			BindChainId: vars[BindChainId],
+			Provider:    provider,
+			Consumer:    consumer,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryEffectivePrices)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func bindingsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		defChainId := vars[DefChainId]
 		serviceName := vars[ServiceName]
 
-		params := service.QueryServiceParams{
-			DefChainID:  defChainId,
-			ServiceName: serviceName,
+		params := service.QueryBindingsParams{
+			DefChainID:     defChainId,
+			ServiceName:    serviceName,
+			MinSuccessRate: r.FormValue("min-success-rate"),
 		}
 
 		bz, err := cdc.MarshalJSON(params)
@@ -211,6 +366,31 @@ func responseGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Hand
 	}
 }
 
+func multicastRequestGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := service.QueryMulticastRequestParams{
+			MulticastRequestId: vars[MulticastReqId],
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryMulticastRequest)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func feesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -242,3 +422,110 @@ func feesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func consumerHistoryHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		consumer, err := sdk.AccAddressFromBech32(vars[Consumer])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pagination, err := convertPaginationParams(r.FormValue("page"), r.FormValue("size"))
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := service.QueryConsumerHistoryParams{
+			Consumer: consumer,
+			Page:     pagination.Page,
+			Size:     pagination.Size,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, path)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func subscriptionGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		subscriptionID, err := strconv.ParseUint(vars[SubscriptionId], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(service.QuerySubscriptionParams{SubscriptionID: subscriptionID})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QuerySubscription)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func consumerSubscriptionsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		consumer, err := sdk.AccAddressFromBech32(vars[Consumer])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(service.QuerySubscriptionsParams{Consumer: consumer})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QuerySubscriptions)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func convertPaginationParams(pageString, sizeString string) (paginationParams sdk.PaginationParams, err error) {
+	page := uint64(1)
+	size := uint16(100)
+	if pageString != "" {
+		page, err = strconv.ParseUint(pageString, 10, 64)
+		if err != nil {
+			return paginationParams, fmt.Errorf("page '%s' is not a valid uint64", pageString)
+		}
+	}
+	if sizeString != "" {
+		sizeUint64, err := strconv.ParseUint(sizeString, 10, 16)
+		if err != nil {
+			return paginationParams, fmt.Errorf("size '%s' is not a valid uint16", sizeString)
+		}
+		size = uint16(sizeUint64)
+	}
+	return sdk.NewPaginationParams(page, size), nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/irisnet/irishub/app/v1/service"
@@ -20,6 +21,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		definitionPostHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// Add a new version of a service definition
+	r.HandleFunc(
+		fmt.Sprintf("/service/definitions/{%s}/{%s}/versions", DefChainId, ServiceName),
+		definitionVersionPostHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// deprecate a version of a service definition
+	r.HandleFunc(
+		fmt.Sprintf("/service/definitions/{%s}/{%s}/versions/{%s}/deprecate", DefChainId, ServiceName, DefVersion),
+		definitionDeprecateHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// Add a new service binding
 	r.HandleFunc(
 		"/service/bindings",
@@ -32,6 +45,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		bindingUpdateHandlerFn(cdc, cliCtx),
 	).Methods("PUT")
 
+	// set the pricing rules of a service binding
+	r.HandleFunc(
+		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/pricing", DefChainId, ServiceName, Provider),
+		bindingPricingHandlerFn(cdc, cliCtx),
+	).Methods("PUT")
+
 	// disable a service binding
 	r.HandleFunc(
 		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/disable", DefChainId, ServiceName, Provider),
@@ -56,12 +75,36 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		requestAddHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// Add a multicast request for multiple service bindings
+	r.HandleFunc(
+		"/service/multicast-requests",
+		multicastRequestAddHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// Add a response for a service request
 	r.HandleFunc(
 		fmt.Sprintf("/service/responses"),
 		responseAddHandlerFn(cdc, cliCtx),
 	).Methods("POST")
 
+	// cancel a service request which has not been responded
+	r.HandleFunc(
+		fmt.Sprintf("/service/requests/{%s}/cancel", ReqId),
+		requestCancelHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// subscribe a service method
+	r.HandleFunc(
+		"/service/subscriptions",
+		subscribeHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// cancel a subscription and refund the escrow left
+	r.HandleFunc(
+		fmt.Sprintf("/service/subscriptions/{%s}/cancel", SubscriptionId),
+		subscriptionCancelHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
 	// refund fees from return fees
 	r.HandleFunc(
 		fmt.Sprintf("/service/fees/{%s}/refund", Consumer),
@@ -107,6 +150,82 @@ func definitionPostHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.H
 	}
 }
 
+func definitionVersionPostHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		defChainId := vars[DefChainId]
+		serviceName := vars[ServiceName]
+
+		var req definitionVersion
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		authorAddr, err := sdk.AccAddressFromBech32(req.AuthorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcDefVersion(serviceName, defChainId, req.ServiceDescription, req.Tags, authorAddr, req.AuthorDescription, req.IdlContent)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func definitionDeprecateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		defChainId := vars[DefChainId]
+		serviceName := vars[ServiceName]
+		version, ok := utils.ParseUint64OrReturnBadRequest(w, vars[DefVersion])
+		if !ok {
+			return
+		}
+
+		var req definitionDeprecate
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		authorAddr, err := sdk.AccAddressFromBech32(req.AuthorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcDefDeprecate(defChainId, serviceName, version, authorAddr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 func bindingAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req binding
@@ -148,7 +267,7 @@ func bindingAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 			prices = append(prices, price)
 		}
 
-		msg := service.NewMsgSvcBind(req.DefChainId, req.ServiceName, baseReq.ChainID, providerAddr, bindingType, deposit, prices, req.Level)
+		msg := service.NewMsgSvcBind(req.DefChainId, req.ServiceName, baseReq.ChainID, providerAddr, bindingType, deposit, prices, req.Level, req.DefVersion)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -226,6 +345,70 @@ func bindingUpdateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 	}
 }
 
+func bindingPricingHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		DefChainId := vars[DefChainId]
+		serviceName := vars[ServiceName]
+		bechProviderAddr := vars[Provider]
+
+		providerAddr, err := sdk.AccAddressFromBech32(bechProviderAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req bindingPricing
+		err = utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		pricing := service.Pricing{Window: req.Window}
+		for _, t := range req.Tiers {
+			discount, err := sdk.NewDecFromStr(t.Discount)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			pricing.Tiers = append(pricing.Tiers, service.VolumeTier{MinRequests: t.MinRequests, Discount: discount})
+		}
+		for _, c := range req.Consumers {
+			consumer, err := sdk.AccAddressFromBech32(c.Consumer)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			var prices []sdk.Coin
+			for _, ip := range c.Prices {
+				price, err := cliCtx.ParseCoin(ip)
+				if err != nil {
+					utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				prices = append(prices, price)
+			}
+			pricing.Consumers = append(pricing.Consumers, service.ConsumerPrice{Consumer: consumer, Prices: prices})
+		}
+
+		msg := service.NewMsgSvcSetPricing(DefChainId, serviceName, baseReq.ChainID, providerAddr, pricing)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 func bindingDisableHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -401,6 +584,61 @@ func requestAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 	}
 }
 
+func multicastRequestAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req multicastRequest
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var providers []sdk.AccAddress
+		for _, providerStr := range req.Providers {
+			provider, err := sdk.AccAddressFromBech32(providerStr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			providers = append(providers, provider)
+		}
+
+		input, err := hex.DecodeString(req.Data)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		serviceFee, err := cliCtx.ParseCoins(req.ServiceFee)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcMulticastRequest(req.DefChainId, req.ServiceName, req.BindChainId, baseReq.ChainID, consumer,
+			providers, req.ProviderCount, req.MethodId, input, serviceFee, req.Profiling)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 func responseAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req serviceResponse
@@ -448,6 +686,137 @@ func responseAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 	}
 }
 
+func requestCancelHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqId := vars[ReqId]
+
+		var req requestCancel
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcCancelRequest(reqId, consumer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func subscribeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req subscription
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		provider, err := sdk.AccAddressFromBech32(req.Provider)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		input, err := hex.DecodeString(req.Data)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		serviceFee, err := cliCtx.ParseCoins(req.ServiceFee)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		escrow, err := cliCtx.ParseCoins(req.Escrow)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcSubscribe(req.DefChainId, req.ServiceName, req.BindChainId, baseReq.ChainID, consumer, provider,
+			req.MethodId, input, serviceFee, req.Interval, req.MaxRepetitions, escrow)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func subscriptionCancelHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		subscriptionID, err := strconv.ParseUint(vars[SubscriptionId], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req subscriptionCancel
+		err = utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgSvcCancelSubscription(subscriptionID, consumer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 func FeesRefundHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -528,6 +897,20 @@ type definition struct {
 	AuthorAddr         string       `json:"author_addr"`
 }
 
+type definitionVersion struct {
+	BaseTx             utils.BaseTx `json:"base_tx"` // basic tx info
+	ServiceDescription string       `json:"service_description"`
+	AuthorDescription  string       `json:"author_description"`
+	Tags               []string     `json:"tags"`
+	IdlContent         string       `json:"idl_content"`
+	AuthorAddr         string       `json:"author_addr"`
+}
+
+type definitionDeprecate struct {
+	BaseTx     utils.BaseTx `json:"base_tx"` // basic tx info
+	AuthorAddr string       `json:"author_addr"`
+}
+
 type binding struct {
 	BaseTx      utils.BaseTx  `json:"base_tx"` // basic tx info
 	ServiceName string        `json:"service_name"`
@@ -537,6 +920,7 @@ type binding struct {
 	Prices      []string      `json:"prices"`
 	Level       service.Level `json:"level"`
 	Provider    string        `json:"provider"`
+	DefVersion  uint64        `json:"def_version"`
 }
 
 type bindingUpdate struct {
@@ -547,6 +931,23 @@ type bindingUpdate struct {
 	Level       service.Level `json:"level"`
 }
 
+type bindingPricing struct {
+	BaseTx    utils.BaseTx    `json:"base_tx"` // basic tx info
+	Window    int64           `json:"window"`
+	Tiers     []volumeTier    `json:"tiers"`
+	Consumers []consumerPrice `json:"consumers"`
+}
+
+type volumeTier struct {
+	MinRequests uint64 `json:"min_requests"`
+	Discount    string `json:"discount"`
+}
+
+type consumerPrice struct {
+	Consumer string   `json:"consumer"`
+	Prices   []string `json:"prices"`
+}
+
 type bindingEnable struct {
 	BaseTx  utils.BaseTx `json:"base_tx"` // basic tx info
 	Deposit string       `json:"deposit"`
@@ -569,6 +970,20 @@ type serviceRequestWithBasic struct {
 	Requests []serviceRequest `json:"requests"`
 }
 
+type multicastRequest struct {
+	BaseTx        utils.BaseTx `json:"base_tx"` // basic tx info
+	ServiceName   string       `json:"service_name"`
+	BindChainId   string       `json:"bind_chain_id"`
+	DefChainId    string       `json:"def_chain_id"`
+	MethodId      int16        `json:"method_id"`
+	Providers     []string     `json:"providers"`
+	ProviderCount uint16       `json:"provider_count"`
+	Consumer      string       `json:"consumer"`
+	ServiceFee    string       `json:"service_fee"`
+	Data          string       `json:"data"`
+	Profiling     bool         `json:"profiling"`
+}
+
 type serviceResponse struct {
 	BaseTx     utils.BaseTx `json:"base_tx"` // basic tx info
 	ReqChainId string       `json:"req_chain_id"`
@@ -578,6 +993,31 @@ type serviceResponse struct {
 	ErrorMsg   string       `json:"error_msg"`
 }
 
+type requestCancel struct {
+	BaseTx   utils.BaseTx `json:"base_tx"` // basic tx info
+	Consumer string       `json:"consumer"`
+}
+
+type subscription struct {
+	BaseTx         utils.BaseTx `json:"base_tx"` // basic tx info
+	ServiceName    string       `json:"service_name"`
+	BindChainId    string       `json:"bind_chain_id"`
+	DefChainId     string       `json:"def_chain_id"`
+	MethodId       int16        `json:"method_id"`
+	Provider       string       `json:"provider"`
+	Consumer       string       `json:"consumer"`
+	ServiceFee     string       `json:"service_fee"`
+	Data           string       `json:"data"`
+	Interval       uint64       `json:"interval"`
+	MaxRepetitions uint64       `json:"max_repetitions"`
+	Escrow         string       `json:"escrow"`
+}
+
+type subscriptionCancel struct {
+	BaseTx   utils.BaseTx `json:"base_tx"` // basic tx info
+	Consumer string       `json:"consumer"`
+}
+
 type basicReq struct {
 	BaseTx utils.BaseTx `json:"base_tx"` // basic tx info
 }
@@ -0,0 +1,10 @@
+package cli
+
+const (
+	flagVoteA = "vote-a"
+	flagVoteB = "vote-b"
+
+	flagWindow = "window"
+
+	flagValidatorPubKey = "validator-pubkey"
+)
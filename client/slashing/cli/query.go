@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/slashing"
 	"github.com/irisnet/irishub/client/context"
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetCmdQuerySigningInfo implements the command to query signing info.
@@ -46,3 +49,199 @@ func GetCmdQuerySigningInfo(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryMissedBlocks implements the command to query the missed-block bitmap of a validator.
+func GetCmdQueryMissedBlocks(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "missed-blocks [validator-pubkey]",
+		Short:   "Query the missed-block bitmap of a validator in the signed blocks window",
+		Example: "iriscli stake missed-blocks <validator public key>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.QueryMissedBlocksParams{
+				ConsAddress: sdk.ConsAddress(pk.Address()),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryMissedBlocks), bz)
+			if err != nil {
+				return err
+			}
+
+			var missedBlocks slashing.ValidatorMissedBlocks
+			if err := cdc.UnmarshalJSON(res, &missedBlocks); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(missedBlocks)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryUptime implements the command to query the uptime of a validator.
+func GetCmdQueryUptime(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "uptime [validator-pubkey]",
+		Short:   "Query the uptime of a validator over the latest blocks",
+		Example: "iriscli stake uptime <validator public key> --window=1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.QueryUptimeParams{
+				ConsAddress: sdk.ConsAddress(pk.Address()),
+				Window:      viper.GetInt64(flagWindow),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryUptime), bz)
+			if err != nil {
+				return err
+			}
+
+			var uptime slashing.ValidatorUptime
+			if err := cdc.UnmarshalJSON(res, &uptime); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(uptime)
+		},
+	}
+
+	cmd.Flags().Int64(flagWindow, 0, "number of the latest blocks to count, not more than the signed blocks window (default the signed blocks window)")
+
+	return cmd
+}
+
+// GetCmdQueryUptimes implements the command to rank all bonded validators by uptime.
+func GetCmdQueryUptimes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "uptimes",
+		Short:   "Rank all bonded validators by uptime over the latest blocks",
+		Example: "iriscli stake uptimes --window=1000",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.QueryUptimesParams{
+				Window: viper.GetInt64(flagWindow),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryUptimes), bz)
+			if err != nil {
+				return err
+			}
+
+			var uptimes slashing.ValidatorUptimes
+			if err := cdc.UnmarshalJSON(res, &uptimes); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(uptimes)
+		},
+	}
+
+	cmd.Flags().Int64(flagWindow, 0, "number of the latest blocks to count, not more than the signed blocks window (default the signed blocks window)")
+
+	return cmd
+}
+
+// GetCmdQueryCensorship implements the command to query the censorship record at a height.
+func GetCmdQueryCensorship(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "censorship [height]",
+		Short:   "Query the proposer censorship record at a height",
+		Example: "iriscli stake censorship 1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.QueryCensorshipParams{
+				Height: height,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryCensorship), bz)
+			if err != nil {
+				return err
+			}
+
+			var record slashing.CensorshipRecord
+			if err := cdc.UnmarshalJSON(res, &record); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(record)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryCensorships implements the command to query the proposer censorship records.
+func GetCmdQueryCensorships(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "censorships",
+		Short:   "Query the proposer censorship records of all validators or of a validator",
+		Example: "iriscli stake censorships --validator-pubkey=<validator public key>",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var consAddr sdk.ConsAddress
+			if strPubKey := viper.GetString(flagValidatorPubKey); len(strPubKey) != 0 {
+				pk, err := sdk.GetConsPubKeyBech32(strPubKey)
+				if err != nil {
+					return err
+				}
+				consAddr = sdk.ConsAddress(pk.Address())
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.QueryCensorshipsParams{
+				ConsAddress: consAddr,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryCensorships), bz)
+			if err != nil {
+				return err
+			}
+
+			var records slashing.CensorshipRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().String(flagValidatorPubKey, "", "consensus public key of the proposer, all proposers if it's not given")
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"io/ioutil"
 	"os"
 
 	"github.com/irisnet/irishub/app/v1/slashing"
@@ -9,6 +10,8 @@ import (
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // GetCmdUnrevoke implements the create unrevoke validator command.
@@ -39,3 +42,61 @@ func GetCmdUnrevoke(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSubmitEvidence implements the submit double sign evidence command.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-evidence",
+		Args:    cobra.ExactArgs(0),
+		Short:   "Submit a pair of conflicting votes signed by a validator as double sign evidence",
+		Example: "iriscli stake submit-evidence --vote-a=<vote-a.json> --vote-b=<vote-b.json> --from=<key-name> --fee=0.3iris --chain-id=<chain-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			submitter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			voteA, err := readVote(cdc, viper.GetString(flagVoteA))
+			if err != nil {
+				return err
+			}
+			voteB, err := readVote(cdc, viper.GetString(flagVoteB))
+			if err != nil {
+				return err
+			}
+
+			msg := slashing.NewMsgSubmitEvidence(submitter, voteA, voteB)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagVoteA, "", "JSON file of the first signed vote, as returned by the tendermint RPC")
+	cmd.Flags().String(flagVoteB, "", "JSON file of the second signed vote conflicting with the first one")
+	cmd.MarkFlagRequired(flagVoteA)
+	cmd.MarkFlagRequired(flagVoteB)
+
+	return cmd
+}
+
+func readVote(cdc *codec.Codec, file string) (*tmtypes.Vote, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var vote tmtypes.Vote
+	if err := cdc.UnmarshalJSON(bz, &vote); err != nil {
+		return nil, err
+	}
+	return &vote, nil
+}
@@ -0,0 +1,6 @@
+package lcd
+
+const (
+	RestWindow          = "window"
+	RestValidatorPubKey = "validator-pubkey"
+)
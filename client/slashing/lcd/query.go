@@ -3,12 +3,14 @@ package lcd
 import (
 	"fmt"
 	"github.com/gorilla/mux"
+	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/slashing"
 	"github.com/irisnet/irishub/client/context"
 	"github.com/irisnet/irishub/client/utils"
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"net/http"
+	"strconv"
 )
 
 // http request handler to query signing info
@@ -45,3 +47,169 @@ func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *code
 		utils.PostProcessResponse(w, cliCtx.Codec, signingInfo, cliCtx.Indent)
 	}
 }
+
+// http request handler to query the missed-block bitmap of a validator
+func missedBlocksHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		pk, err := sdk.GetConsPubKeyBech32(vars["validatorPubKey"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := slashing.QueryMissedBlocksParams{
+			ConsAddress: sdk.ConsAddress(pk.Address()),
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryMissedBlocks), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to query the uptime of a validator
+func uptimeHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		pk, err := sdk.GetConsPubKeyBech32(vars["validatorPubKey"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		window, err := parseWindow(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := slashing.QueryUptimeParams{
+			ConsAddress: sdk.ConsAddress(pk.Address()),
+			Window:      window,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryUptime), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to rank all bonded validators by uptime
+func uptimesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		window, err := parseWindow(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := slashing.QueryUptimesParams{
+			Window: window,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryUptimes), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to query the proposer censorship record at a height
+func censorshipHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		height, err := strconv.ParseInt(vars["height"], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := slashing.QueryCensorshipParams{
+			Height: height,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryCensorship), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to query the proposer censorship records, optionally of a validator
+func censorshipsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var consAddr sdk.ConsAddress
+		if strPubKey := r.URL.Query().Get(RestValidatorPubKey); len(strPubKey) != 0 {
+			pk, err := sdk.GetConsPubKeyBech32(strPubKey)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			consAddr = sdk.ConsAddress(pk.Address())
+		}
+
+		params := slashing.QueryCensorshipsParams{
+			ConsAddress: consAddr,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.SlashingRoute, slashing.QueryCensorships), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// the window is optional, the signed blocks window is used if it's not given
+func parseWindow(r *http.Request) (int64, error) {
+	strWindow := r.URL.Query().Get(RestWindow)
+	if len(strWindow) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(strWindow, 10, 64)
+}
@@ -10,6 +10,16 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/slashing/validators/{validatorPubKey}/signing-info",
 		signingInfoHandlerFn(cliCtx, "slashing", cdc)).Methods("GET")
+	r.HandleFunc("/slashing/validators/{validatorPubKey}/missed-blocks",
+		missedBlocksHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/slashing/validators/{validatorPubKey}/uptime",
+		uptimeHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/slashing/uptimes",
+		uptimesHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/slashing/censorships",
+		censorshipsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/slashing/censorships/{height}",
+		censorshipHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/slashing/validators/{validatorAddr}/unjail",
 		unrevokeRequestHandlerFn(cdc, cliCtx)).Methods("POST")
 }
@@ -12,6 +12,7 @@ const (
 	FlagAddressValidator    = "address-validator"
 	FlagAddressValidatorSrc = "address-validator-source"
 	FlagAddressValidatorDst = "address-validator-dest"
+	FlagAddressDelegatorDst = "address-delegator-dest"
 	FlagPubKey              = "pubkey"
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
@@ -24,6 +25,8 @@ const (
 
 	FlagCommissionRate = "commission-rate"
 
+	FlagMinSelfDelegation = "min-self-delegation"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -43,19 +46,24 @@ var (
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsTransfer          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMinSelfDelegation = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMinSelfDelUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsPk.String(FlagPubKey, "", "Go-Amino encoded hex PubKey of the validator. For Ed25519 the go-amino prepend hex is 1624de6220")
 	FsAmount.String(FlagAmount, "", "Amount of coins to bond")
-	fsShares.String(FlagSharesAmount, "", "Amount of source-shares to either unbond or redelegate as a positive integer or decimal")
-	fsShares.String(FlagSharesPercent, "", "Percent of source-shares to either unbond or redelegate as a positive integer or decimal >0 and <=1")
+	fsShares.String(FlagSharesAmount, "", "Amount of source-shares to either unbond, redelegate or transfer as a positive integer or decimal")
+	fsShares.String(FlagSharesPercent, "", "Percent of source-shares to either unbond, redelegate or transfer as a positive integer or decimal >0 and <=1")
 	fsDescriptionCreate.String(FlagMoniker, "", "validator name")
 	fsDescriptionCreate.String(FlagIdentity, "", "optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionCreate.String(FlagWebsite, "", "optional website")
 	fsDescriptionCreate.String(FlagDetails, "", "optional details")
-	fsCommissionUpdate.String(FlagCommissionRate, "", "The new commission rate percentage")
+	fsCommissionUpdate.String(FlagCommissionRate, "", "The new commission rate percentage, applied after the commission notice period")
 	FsCommissionCreate.String(FlagCommissionRate, "", "The initial commission rate percentage")
+	FsMinSelfDelegation.String(FlagMinSelfDelegation, "", "The minimum self-delegation of the validator, the validator is jailed once its self-delegation drops below it (optional, no minimum if not given)")
+	fsMinSelfDelUpdate.String(FlagMinSelfDelegation, "", "The new minimum self-delegation of the validator, it can only be raised")
 	fsDescriptionEdit.String(FlagMoniker, types.DoNotModifyDesc, "validator name")
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "optional website")
@@ -64,4 +72,5 @@ func init() {
 	fsDelegator.String(FlagAddressDelegator, "", "bech address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "bech address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "bech address of the destination validator")
+	fsTransfer.String(FlagAddressDelegatorDst, "", "bech address of the destination delegator")
 }
@@ -20,7 +20,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "create-validator",
 		Short:   "Create new validator initialized with a self-delegation to it",
-		Example: "iriscli stake create-validator --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --pubkey=<validator public key> --amount=10iris --moniker=<validator name> --commission-rate=0.1",
+		Example: "iriscli stake create-validator --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --pubkey=<validator public key> --amount=10iris --moniker=<validator name> --commission-rate=0.1 --min-self-delegation=1iris",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().
 				WithCodec(cdc).
@@ -73,6 +73,16 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// no minimum self-delegation is required if it is not given
+			var minSelfDelegation sdk.Int
+			if minSelfDelegationStr := viper.GetString(FlagMinSelfDelegation); minSelfDelegationStr != "" {
+				minSelfDelegationCoin, err := cliCtx.ParseCoin(minSelfDelegationStr)
+				if err != nil {
+					return err
+				}
+				minSelfDelegation = minSelfDelegationCoin.Amount
+			}
+
 			var msg sdk.Msg
 			if viper.GetString(FlagAddressDelegator) != "" {
 				delAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegator))
@@ -82,11 +92,11 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 
 				msg = stake.NewMsgCreateValidatorOnBehalfOf(
 					delAddr, sdk.ValAddress(validatorAddr), pk, amount, description, commissionMsg,
-				)
+				).WithMinSelfDelegation(minSelfDelegation)
 			} else {
 				msg = stake.NewMsgCreateValidator(
 					sdk.ValAddress(validatorAddr), pk, amount, description, commissionMsg,
-				)
+				).WithMinSelfDelegation(minSelfDelegation)
 			}
 
 			if viper.GetBool(FlagGenesisFormat) {
@@ -109,6 +119,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(FsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
 	cmd.Flags().AddFlagSet(FsCommissionCreate)
+	cmd.Flags().AddFlagSet(FsMinSelfDelegation)
 	cmd.Flags().AddFlagSet(fsDelegator)
 	cmd.Flags().Bool(FlagGenesisFormat, false, "Export the transaction in gen-tx format; it implies --generate-only")
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("Node's public IP. It takes effect only when used in combination with --%s", FlagGenesisFormat))
@@ -160,6 +171,16 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 
 			msg := stake.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate)
 
+			minSelfDelegationStr := viper.GetString(FlagMinSelfDelegation)
+			if minSelfDelegationStr != "" {
+				minSelfDelegation, err := cliCtx.ParseCoin(minSelfDelegationStr)
+				if err != nil {
+					return fmt.Errorf("invalid new minimum self-delegation: %v", err)
+				}
+
+				msg = msg.WithMinSelfDelegation(minSelfDelegation.Amount)
+			}
+
 			if cliCtx.GenerateOnly {
 				return utils.PrintUnsignedStdTx(txCtx, cliCtx, []sdk.Msg{msg}, false)
 			}
@@ -171,6 +192,7 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
 	cmd.Flags().AddFlagSet(fsCommissionUpdate)
+	cmd.Flags().AddFlagSet(fsMinSelfDelUpdate)
 
 	return cmd
 }
@@ -318,3 +340,58 @@ func GetCmdUnbond(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTransferDelegation implements the transfer delegation command.
+func GetCmdTransferDelegation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-delegation",
+		Short:   "Transfer delegation shares of a validator to another delegator",
+		Example: "iriscli stake transfer-delegation --chain-id=<chain-id> --from=<key-name> --fee=0.3iris --address-validator=<validator address> --address-delegator-dest=<destination delegator address> --shares-percent=0.5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			delegatorDstAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegatorDst))
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			// get the shares amount
+			sharesAmountStr := viper.GetString(FlagSharesAmount)
+			sharesPercentStr := viper.GetString(FlagSharesPercent)
+			sharesAmount, err := stakeClient.GetShares(
+				protocol.StakeStore, cliCtx, cdc, sharesAmountStr, sharesPercentStr,
+				delegatorAddr, validatorAddr,
+			)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgTransferDelegation(delegatorAddr, delegatorDstAddr, validatorAddr, sharesAmount)
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsShares)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsTransfer)
+	cmd.MarkFlagRequired(FlagAddressValidator)
+	cmd.MarkFlagRequired(FlagAddressDelegatorDst)
+
+	return cmd
+}
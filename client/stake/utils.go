@@ -116,34 +116,48 @@ func (reds RedelegationsOutput) String() (out string) {
 }
 
 type ValidatorOutput struct {
-	OperatorAddr     sdk.ValAddress    `json:"operator_address"`
-	ConsPubKey       string            `json:"consensus_pubkey"`
-	Jailed           bool              `json:"jailed"`
-	Status           sdk.BondStatus    `json:"status"`
-	Tokens           string            `json:"tokens"`
-	DelegatorShares  string            `json:"delegator_shares"`
-	Description      stake.Description `json:"description"`
-	BondHeight       int64             `json:"bond_height"`
-	UnbondingHeight  int64             `json:"unbonding_height"`
-	UnbondingMinTime time.Time         `json:"unbonding_time"`
-	Commission       stake.Commission  `json:"commission"`
+	OperatorAddr      sdk.ValAddress           `json:"operator_address"`
+	ConsPubKey        string                   `json:"consensus_pubkey"`
+	Jailed            bool                     `json:"jailed"`
+	Status            sdk.BondStatus           `json:"status"`
+	Tokens            string                   `json:"tokens"`
+	DelegatorShares   string                   `json:"delegator_shares"`
+	Description       stake.Description        `json:"description"`
+	BondHeight        int64                    `json:"bond_height"`
+	UnbondingHeight   int64                    `json:"unbonding_height"`
+	UnbondingMinTime  time.Time                `json:"unbonding_time"`
+	Commission        stake.Commission         `json:"commission"`
+	PendingCommission *stake.PendingCommission `json:"pending_commission"`
+	MinSelfDelegation string                   `json:"min_self_delegation"`
+	JailReason        string                   `json:"jail_reason"`
 }
 
 func (v ValidatorOutput) String() string {
+	pendingCommission := "none"
+	if v.PendingCommission != nil {
+		pendingCommission = v.PendingCommission.String()
+	}
+	jailReason := "none"
+	if len(v.JailReason) > 0 {
+		jailReason = v.JailReason
+	}
 	return fmt.Sprintf(`Validator
   Operator Address:            %s
   Validator Consensus Pubkey:  %s
   Jailed:                      %v
+  Jail Reason:                 %s
   Status:                      %s
   Tokens:                      %s
   Delegator Shares:            %s
   Description:                 %s
   Unbonding Height:            %d
   Minimum Unbonding Time:      %v
-  Commission:                  %s`, v.OperatorAddr, v.ConsPubKey,
-		v.Jailed, sdk.BondStatusToString(v.Status), v.Tokens,
+  Commission:                  %s
+  Pending Commission:          %s
+  Min Self Delegation:         %s`, v.OperatorAddr, v.ConsPubKey,
+		v.Jailed, jailReason, sdk.BondStatusToString(v.Status), v.Tokens,
 		v.DelegatorShares, v.Description,
-		v.UnbondingHeight, v.UnbondingMinTime, v.Commission)
+		v.UnbondingHeight, v.UnbondingMinTime, v.Commission, pendingCommission, v.MinSelfDelegation)
 }
 
 // Validators is a collection of Validator
@@ -184,17 +198,20 @@ func ConvertValidatorToValidatorOutput(cliCtx context.CLIContext, v stake.Valida
 	}
 
 	return ValidatorOutput{
-		OperatorAddr:     v.OperatorAddr,
-		ConsPubKey:       bechConsPubkey,
-		Jailed:           v.Jailed,
-		Status:           v.Status,
-		Tokens:           utils.ConvertDecToRat(v.Tokens).Mul(exRate).FloatString(),
-		DelegatorShares:  utils.ConvertDecToRat(v.DelegatorShares).Mul(exRate).FloatString(),
-		Description:      v.Description,
-		BondHeight:       v.BondHeight,
-		UnbondingHeight:  v.UnbondingHeight,
-		UnbondingMinTime: v.UnbondingMinTime,
-		Commission:       v.Commission,
+		OperatorAddr:      v.OperatorAddr,
+		ConsPubKey:        bechConsPubkey,
+		Jailed:            v.Jailed,
+		Status:            v.Status,
+		Tokens:            utils.ConvertDecToRat(v.Tokens).Mul(exRate).FloatString(),
+		DelegatorShares:   utils.ConvertDecToRat(v.DelegatorShares).Mul(exRate).FloatString(),
+		Description:       v.Description,
+		BondHeight:        v.BondHeight,
+		UnbondingHeight:   v.UnbondingHeight,
+		UnbondingMinTime:  v.UnbondingMinTime,
+		Commission:        v.Commission,
+		PendingCommission: v.PendingCommission,
+		MinSelfDelegation: utils.ConvertDecToRat(sdk.NewDecFromInt(v.MinSelfDelegation)).Mul(exRate).FloatString(),
+		JailReason:        v.JailReason,
 	}
 }
 
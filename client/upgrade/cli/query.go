@@ -4,8 +4,7 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/irisnet/irishub/app/v1/stake"
-	"github.com/irisnet/irishub/app/v1/stake/types"
+	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/upgrade"
 	"github.com/irisnet/irishub/client/context"
 	upgcli "github.com/irisnet/irishub/client/upgrade"
@@ -68,8 +67,8 @@ func GetInfoCmd(storeName string, cdc *codec.Codec) *cobra.Command {
 func GetCmdQuerySignals(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "query-signals",
-		Short:   "Query the information of signals",
-		Example: "iriscli upgrade query-signals",
+		Short:   "Query the signal state of the bonded validators for the upgrade in progress",
+		Example: "iriscli upgrade query-signals --detail",
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			cliCtx := context.NewCLIContext().
@@ -77,58 +76,20 @@ func GetCmdQuerySignals(storeName string, cdc *codec.Codec) *cobra.Command {
 				WithLogger(os.Stdout).
 				WithAccountDecoder(utils.GetAccountDecoder(cdc))
 
-			res_upgradeConfig, err := cliCtx.QueryStore(sdk.UpgradeConfigKey, sdk.MainStore)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.UpgradeRoute, upgrade.QuerySignals), nil)
 			if err != nil {
 				return err
 			}
-			if len(res_upgradeConfig) == 0 {
-				fmt.Println("No Software Upgrade Switch Period is in process.")
-				return err
-			}
-
-			var upgradeConfig sdk.UpgradeConfig
-			if err = cdc.UnmarshalBinaryLengthPrefixed(res_upgradeConfig, &upgradeConfig); err != nil {
-				return err
-			}
-
-			validatorConsAddrs := make(map[string]bool)
-			res, err := cliCtx.QuerySubspace(upgrade.GetSignalPrefixKey(upgradeConfig.Protocol.Version), storeName)
-			if err != nil {
-				return err
-			}
-
-			for _, kv := range res {
-				validatorConsAddrs[upgrade.GetAddressFromSignalKey(kv.Key)] = true
-			}
 
-			if len(validatorConsAddrs) == 0 {
-				fmt.Println("No validator has started the new version.")
-				return nil
-			}
-
-			key := stake.ValidatorsKey
-			resKVs, err := cliCtx.QuerySubspace(key, "stake")
-			if err != nil {
+			var signals upgrade.UpgradeSignals
+			if err := cdc.UnmarshalJSON(res, &signals); err != nil {
 				return err
 			}
 
-			isDetail := viper.GetBool(flagDetail)
-			totalVotingPower := sdk.ZeroDec()
-			signalsVotingPower := sdk.ZeroDec()
-
-			for _, kv := range resKVs {
-				addr := kv.Key[1:]
-				validator := types.MustUnmarshalValidator(cdc, addr, kv.Value)
-				totalVotingPower = totalVotingPower.Add(validator.GetPower())
-				if _, ok := validatorConsAddrs[validator.GetConsAddr().String()]; ok {
-					signalsVotingPower = signalsVotingPower.Add(validator.GetPower())
-					if isDetail {
-						fmt.Println(validator.GetOperator().String(), " ", validator.GetPower().String())
-					}
-				}
+			if !viper.GetBool(flagDetail) {
+				signals.Validators = nil
 			}
-			fmt.Println("signalsVotingPower/totalVotingPower = " + signalsVotingPower.Quo(totalVotingPower).String())
-			return nil
+			return cliCtx.PrintOutput(signals)
 		},
 	}
 	cmd.Flags().Bool(flagDetail, false, "details of siganls")
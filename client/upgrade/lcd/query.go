@@ -1,8 +1,10 @@
 package lcd
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/upgrade"
 	"github.com/irisnet/irishub/client/context"
 	upgcli "github.com/irisnet/irishub/client/upgrade"
@@ -58,3 +60,15 @@ func InfoHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, storeName string
 		w.Write(output)
 	}
 }
+
+func signalsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.UpgradeRoute, upgrade.QuerySignals), nil)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
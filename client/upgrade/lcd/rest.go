@@ -0,0 +1,13 @@
+package lcd
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/codec"
+)
+
+// RegisterRoutes registers upgrade-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/upgrade/info", InfoHandlerFn(cliCtx, cdc, "upgrade")).Methods("GET")
+	r.HandleFunc("/upgrade/signals", signalsHandlerFn(cliCtx, cdc)).Methods("GET")
+}
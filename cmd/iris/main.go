@@ -68,6 +68,7 @@ func main() {
 		server.ResetCmd(ctx, cdc, resetAppState),
 		server.ExportCmd(ctx, cdc, exportAppStateAndTMValidators),
 		server.SnapshotCmd(ctx, cdc, resetAppState),
+		server.UpgradeDryRunCmd(ctx, cdc, dryRunUpgrade),
 		client.LineBreak,
 	)
 
@@ -127,6 +128,20 @@ func resetAppState(ctx *server.Context,
 	return nil
 }
 
+func dryRunUpgrade(ctx *server.Context,
+	logger log.Logger, db dbm.DB, traceStore io.Writer, version uint64) (fmt.Stringer, bool, error) {
+	gApp := app.NewIrisApp(logger, db, ctx.Config.Instrumentation, traceStore)
+	header, err := server.LoadCommittedHeader(ctx.Config.DBDir(), gApp.LastBlockHeight())
+	if err != nil {
+		return nil, false, err
+	}
+	result, err := gApp.DryRunUpgrade(version, header)
+	if err != nil {
+		return nil, false, err
+	}
+	return result, result.Success, nil
+}
+
 func startNodeAndReplay(ctx *server.Context, app *app.IrisApp, height int64) (n *node.Node, err error) {
 	cfg := ctx.Config
 	cfg.BaseConfig.ReplayHeight = height
@@ -140,12 +140,20 @@ func main() {
 			govcmd.GetCmdQueryDeposit(cdc),
 			govcmd.GetCmdQueryDeposits(cdc),
 			govcmd.GetCmdQueryTally(cdc),
+			govcmd.GetCmdQueryTallySimulation(cdc),
+			govcmd.GetCmdQueryProposalRecord(cdc),
+			govcmd.GetCmdQueryProposalRecords(cdc),
+			govcmd.GetCmdQueryRepresentative(cdc),
+			govcmd.GetCmdQueryRepresentedDelegators(cdc),
 		)...)
 	govCmd.AddCommand(
 		client.PostCommands(
 			govcmd.GetCmdSubmitProposal(cdc),
 			govcmd.GetCmdDeposit(cdc),
+			govcmd.GetCmdCancelProposal(cdc),
 			govcmd.GetCmdVote(cdc),
+			govcmd.GetCmdWeightedVote(cdc),
+			govcmd.GetCmdSetRepresentative(cdc),
 		)...)
 	rootCmd.AddCommand(
 		govCmd,
@@ -172,6 +180,11 @@ func main() {
 			stakecmd.GetCmdQueryPool(cdc),
 			stakecmd.GetCmdQueryParams(cdc),
 			slashingcmd.GetCmdQuerySigningInfo(protocol.SlashingRoute, cdc),
+			slashingcmd.GetCmdQueryMissedBlocks(cdc),
+			slashingcmd.GetCmdQueryUptime(cdc),
+			slashingcmd.GetCmdQueryUptimes(cdc),
+			slashingcmd.GetCmdQueryCensorship(cdc),
+			slashingcmd.GetCmdQueryCensorships(cdc),
 		)...)
 	stakeCmd.AddCommand(
 		client.PostCommands(
@@ -180,7 +193,9 @@ func main() {
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdUnbond(cdc),
 			stakecmd.GetCmdRedelegate(cdc),
+			stakecmd.GetCmdTransferDelegation(cdc),
 			slashingcmd.GetCmdUnrevoke(cdc),
+			slashingcmd.GetCmdSubmitEvidence(cdc),
 		)...)
 	rootCmd.AddCommand(
 		stakeCmd,
@@ -208,21 +223,37 @@ func main() {
 	serviceCmd.AddCommand(
 		client.GetCommands(
 			servicecmd.GetCmdQuerySvcDef(cdc),
+			servicecmd.GetCmdQuerySvcDefVersions(cdc),
 			servicecmd.GetCmdQuerySvcBind(cdc),
+			servicecmd.GetCmdQuerySvcEffectivePrices(cdc),
+			servicecmd.GetCmdQuerySvcBindingStats(cdc),
 			servicecmd.GetCmdQuerySvcBinds(cdc),
 			servicecmd.GetCmdQuerySvcRequests(cdc),
 			servicecmd.GetCmdQuerySvcResponse(cdc),
+			servicecmd.GetCmdQuerySvcMulticastRequest(cdc),
 			servicecmd.GetCmdQuerySvcFees(cdc),
+			servicecmd.GetCmdQuerySvcSubscription(cdc),
+			servicecmd.GetCmdQuerySvcSubscriptions(cdc),
+			servicecmd.GetCmdQuerySvcConsumerRequests(cdc),
+			servicecmd.GetCmdQuerySvcConsumerResponses(cdc),
 		)...)
 	serviceCmd.AddCommand(client.PostCommands(
 		servicecmd.GetCmdSvcDef(cdc),
+		servicecmd.GetCmdSvcDefVersion(cdc),
+		servicecmd.GetCmdSvcDefDeprecate(cdc),
 		servicecmd.GetCmdSvcBind(cdc),
 		servicecmd.GetCmdSvcBindUpdate(cdc),
+		servicecmd.GetCmdSvcSetPricing(cdc),
 		servicecmd.GetCmdSvcDisable(cdc),
 		servicecmd.GetCmdSvcEnable(cdc),
 		servicecmd.GetCmdSvcRefundDeposit(cdc),
 		servicecmd.GetCmdSvcCall(cdc),
+		servicecmd.GetCmdSvcMulticast(cdc),
 		servicecmd.GetCmdSvcRespond(cdc),
+		servicecmd.GetCmdSvcCancel(cdc),
+		servicecmd.GetCmdSvcSubscribe(cdc),
+		servicecmd.GetCmdSvcCancelSubscription(cdc),
+		servicecmd.GetCmdSvcServe(cdc),
 		servicecmd.GetCmdSvcRefundFees(cdc),
 		servicecmd.GetCmdSvcWithdrawFees(cdc),
 		servicecmd.GetCmdSvcWithdrawTax(cdc),
@@ -278,6 +309,7 @@ func main() {
 			assetcmd.GetCmdQueryGateway(cdc),
 			assetcmd.GetCmdQueryGateways(cdc),
 			assetcmd.GetCmdQueryFee(cdc),
+			assetcmd.GetCmdQueryVestings(cdc),
 		)...)
 
 	rootCmd.AddCommand(
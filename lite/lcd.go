@@ -21,6 +21,7 @@ import (
 	rpchandler "github.com/irisnet/irishub/client/tendermint/rpc"
 	ttxhandler "github.com/irisnet/irishub/client/tendermint/tx"
 	txhandler "github.com/irisnet/irishub/client/tx/lcd"
+	upgradehandler "github.com/irisnet/irishub/client/upgrade/lcd"
 	"github.com/irisnet/irishub/codec"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cobra"
@@ -112,6 +113,7 @@ func createHandler(cdc *codec.Codec) *mux.Router {
 	paramshandler.RegisterRoutes(cliCtx, r, cdc)
 	coinswaphandler.RegisterRoutes(cliCtx, r, cdc)
 	htlchandler.RegisterRoutes(cliCtx, r, cdc)
+	upgradehandler.RegisterRoutes(cliCtx, r, cdc)
 	// tendermint apis
 	rpchandler.RegisterRoutes(cliCtx, r, cdc)
 	ttxhandler.RegisterRoutes(cliCtx, r, cdc)
@@ -456,7 +456,8 @@ func (v Validator) GetPower() sdk.Dec {
 func (v Validator) GetPotentialPower() sdk.Dec {
 	return v.Tokens.QuoInt(sdk.AttoScaleFactor)
 }
-func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
-func (v Validator) GetCommission() sdk.Dec      { return v.Commission.Rate }
-func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
-func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+func (v Validator) GetTokens() sdk.Dec            { return v.Tokens }
+func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
+func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+func (v Validator) GetBondHeight() int64          { return v.BondHeight }
+func (v Validator) GetMinSelfDelegation() sdk.Int { return sdk.ZeroInt() }
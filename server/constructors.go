@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -24,6 +25,10 @@ type (
 
 	// AppReset is a function that reset all app state to particular height
 	AppReset func(*Context, log.Logger, dbm.DB, io.Writer, int64) error
+
+	// AppUpgradeDryRunner is a function that activates a protocol version on the app state
+	// without committing it and returns the report and whether the upgrade succeeded
+	AppUpgradeDryRunner func(*Context, log.Logger, dbm.DB, io.Writer, uint64) (fmt.Stringer, bool, error)
 )
 
 func openDB(rootDir string) (dbm.DB, error) {
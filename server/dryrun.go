@@ -0,0 +1,97 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/irisnet/irishub/codec"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	abci "github.com/tendermint/tendermint/abci/types"
+	bc "github.com/tendermint/tendermint/blockchain"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	flagVersion = "version"
+)
+
+// UpgradeDryRunCmd activates a protocol version on the current app state without committing it
+func UpgradeDryRunCmd(ctx *Context, cdc *codec.Codec, appUpgradeDryRunner AppUpgradeDryRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run",
+		Short: "Dry run the upgrade to a protocol version on the current app state",
+		Long: `Load the latest app state from the data directory, activate the given protocol version in a cache store,
+run its initialization and all its invariants, and report the result with the changes of the store roots.
+Nothing is written into the data directory. The node must be stopped before running this command.`,
+		Example: "iris upgrade-dry-run --version=2",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home := viper.GetString(tmcli.HomeFlag)
+			traceWriterFile := viper.GetString(flagTraceStore)
+			emptyState, err := isEmptyState(home)
+			if err != nil {
+				return err
+			}
+
+			if emptyState {
+				fmt.Println("WARNING: State is not initialized.")
+				return nil
+			}
+
+			db, err := openReadOnlyDB(home)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			version := viper.GetInt64(flagVersion)
+			if version <= 0 {
+				return errors.Errorf("Version must greater than zero")
+			}
+
+			result, success, err := appUpgradeDryRunner(ctx, ctx.Logger, db, traceWriter, uint64(version))
+			if err != nil {
+				return errors.Errorf("Error dry running upgrade: %v\n", err)
+			}
+
+			fmt.Println(result.String())
+
+			if !success {
+				return errors.Errorf("Upgrade dry run to version %d failed", version)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagVersion, 0, "The protocol version to be activated")
+	cmd.MarkFlagRequired(flagVersion)
+	return cmd
+}
+
+func openReadOnlyDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+}
+
+// LoadCommittedHeader loads the header of a committed block from the block store in the data directory
+func LoadCommittedHeader(dataDir string, height int64) (abci.Header, error) {
+	db, err := dbm.NewGoLevelDBWithOpts("blockstore", dataDir, &opt.Options{ReadOnly: true})
+	if err != nil {
+		return abci.Header{}, err
+	}
+	defer db.Close()
+
+	meta := bc.NewBlockStore(db).LoadBlockMeta(height)
+	if meta == nil {
+		return abci.Header{}, fmt.Errorf("block %d is not found in the block store", height)
+	}
+	return tmtypes.TM2PB.Header(&meta.Header), nil
+}
@@ -150,6 +150,11 @@ func (st *iavlStore) LastCommitID() CommitID {
 	}
 }
 
+// WorkingHash returns the root hash of the tree including the uncommitted changes
+func (st *iavlStore) WorkingHash() []byte {
+	return st.tree.WorkingHash()
+}
+
 // Implements Committer.
 func (st *iavlStore) SetPruning(pruning sdk.PruningStrategy) {
 	switch pruning {
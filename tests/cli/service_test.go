@@ -111,7 +111,10 @@ func TestIrisCLIService(t *testing.T) {
 	}
 
 	serviceBinding := executeGetServiceBinding(t, fmt.Sprintf("iriscli service binding --service-name=%s --def-chain-id=%s --bind-chain-id=%s --provider=%s %v", serviceName, chainID, chainID, fooAddr.String(), flags))
-	require.NotNil(t, serviceBinding)
+	require.Equal(t, fooAddr, serviceBinding.Provider)
+
+	bindingStats := executeGetServiceBindingStats(t, fmt.Sprintf("iriscli service binding-stats --service-name=%s --def-chain-id=%s --bind-chain-id=%s --provider=%s %v", serviceName, chainID, chainID, fooAddr.String(), flags))
+	require.Equal(t, uint64(0), bindingStats.Stats.ServedRequests)
 
 	serviceBindings := executeGetServiceBindings(t, fmt.Sprintf("iriscli service bindings --service-name=%s --def-chain-id=%s %v", serviceName, chainID, flags))
 	require.Equal(t, 2, len(serviceBindings))
@@ -164,7 +167,7 @@ func TestIrisCLIService(t *testing.T) {
 	caStr += fmt.Sprintf(" --bind-chain-id=%s", chainID)
 	caStr += fmt.Sprintf(" --method-id=%d", 1)
 	caStr += fmt.Sprintf(" --provider=%s", fooAddr.String())
-	caStr += fmt.Sprintf(" --request-data=%s", "1234")
+	caStr += fmt.Sprintf(" --request-json=%s", `{"name":"iris"}`)
 	caStr += fmt.Sprintf(" --service-fee=%s", "2iris")
 	caStr += fmt.Sprintf(" --fee=%s", "0.4iris")
 	caStr += fmt.Sprintf(" --from=%s", "bar")
@@ -190,7 +193,7 @@ func TestIrisCLIService(t *testing.T) {
 	reStr := fmt.Sprintf("iriscli service respond %v", flags)
 	reStr += fmt.Sprintf(" --request-chain-id=%s", chainID)
 	reStr += fmt.Sprintf(" --request-id=%s", requestId)
-	reStr += fmt.Sprintf(" --response-data=%s", "1234")
+	reStr += fmt.Sprintf(" --response-json=%s", `{"message":"hello"}`)
 	reStr += fmt.Sprintf(" --fee=%s", "0.4iris")
 	reStr += fmt.Sprintf(" --from=%s", "foo")
 
@@ -68,6 +68,7 @@ func TestIrisCLIStakeCreateValidator(t *testing.T) {
 	validator := executeGetValidator(t, fmt.Sprintf("iriscli stake validator %s --output=json %v", valAddr, flags))
 	require.Equal(t, valAddr, validator.OperatorAddr.String())
 	require.Equal(t, "2.0000000000000000000000000000", validator.Tokens)
+	require.Equal(t, "0.0000000000000000000000000000", validator.MinSelfDelegation)
 
 	// unbond a single share
 	unbondStr := fmt.Sprintf("iriscli stake unbond %v", flags)
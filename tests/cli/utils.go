@@ -330,6 +330,15 @@ func executeGetServiceBindings(t *testing.T, cmdStr string) []service.SvcBinding
 	return serviceBindings
 }
 
+func executeGetServiceBindingStats(t *testing.T, cmdStr string) service.BindingStatsOutput {
+	out, _ := tests.ExecuteT(t, cmdStr, "")
+	var stats service.BindingStatsOutput
+	cdc := app.MakeLatestCodec()
+	err := cdc.UnmarshalJSON([]byte(out), &stats)
+	require.NoError(t, err, "out %v\n, err %v", out, err)
+	return stats
+}
+
 func executeGetProfilers(t *testing.T, cmdStr string) []guardian.Guardian {
 	out, _ := tests.ExecuteT(t, cmdStr, "")
 	var profilers []guardian.Guardian
@@ -47,10 +47,12 @@ func GetMethods(content string) (methods []Method, err error) {
 			rs = append(rs, r)
 		}),
 		proto.WithMessage(func(m *proto.Message) {
-			if _, ok := mm[m.Name]; ok {
-				err = fmt.Errorf("contains duplicate messages %s", m.Name)
+			// nested messages are distinguished by the messages they are nested in
+			name := qualifiedName(m.Parent, m.Name)
+			if _, ok := mm[name]; ok {
+				err = fmt.Errorf("contains duplicate messages %s", name)
 			}
-			mm[m.Name] = m
+			mm[name] = m
 			ms = append(ms, m)
 		}),
 	)
@@ -59,6 +61,8 @@ func GetMethods(content string) (methods []Method, err error) {
 		return methods, err
 	}
 
+	s := newSchema(definition)
+
 	// get method attribute from comment, each line comment only define one attribute
 	for _, r := range rs {
 		attributes := make(map[string]string)
@@ -69,8 +73,15 @@ func GetMethods(content string) (methods []Method, err error) {
 			}
 		}
 		method := Method{
-			r.Name,
-			attributes,
+			Name:       r.Name,
+			Attributes: attributes,
+		}
+		// messages imported from other files can not be resolved, leave them undescribed
+		if request, err := s.descriptor(r.RequestType); err == nil {
+			method.Request = request
+		}
+		if response, err := s.descriptor(r.ReturnsType); err == nil {
+			method.Response = response
 		}
 		methods = append(methods, method)
 	}
@@ -68,3 +68,145 @@ func TestGetMethods(t *testing.T) {
 		require.Equal(t, methods[0].Attributes, map[string]string{"description": "sayHello", "output_cached": "NoCached", "output_privacy": "NoPrivacy"})
 	}
 }
+
+const payloadIDL = `syntax = "proto3";
+
+package test;
+
+service Test {
+	rpc Call (Request) returns (Response) {}
+	rpc Empty (google.protobuf.Empty) returns (Response) {}
+}
+
+enum Kind {
+	UNKNOWN = 0;
+	KNOWN = 1;
+}
+
+message Request {
+	string name = 1;
+	repeated int64 values = 2;
+	Inner inner = 3;
+	map<string, int32> labels = 4;
+	Kind kind = 5;
+	oneof choice {
+		bytes data = 6;
+		double ratio = 7;
+	}
+}
+
+message Inner {
+	bool flag = 1;
+	sint32 delta = 2;
+}
+
+message Response {
+	string message = 1;
+}`
+
+func TestGetMethodsDescriptors(t *testing.T) {
+	methods, err := GetMethods(payloadIDL)
+	require.NoError(t, err)
+	require.Len(t, methods, 2)
+
+	require.Equal(t, "Request", methods[0].Request.Messages[0].Name)
+	require.Len(t, methods[0].Request.Messages, 3)
+	require.Equal(t, Field{"kind", 5, TypeEnum, false}, methods[0].Request.Messages[0].Fields[4])
+	require.Equal(t, "Response", methods[0].Response.Messages[0].Name)
+
+	// imported messages are not described
+	require.True(t, methods[1].Request.Empty())
+	require.NoError(t, methods[1].Request.Validate([]byte{0xff}))
+}
+
+const nestedIDL = `
+syntax = "proto3";
+
+package nested;
+
+service Nested {
+    rpc Call (Request) returns (Response) {}
+}
+
+message Request {
+	message Item {
+		string name = 1;
+	}
+	Item item = 1;
+	Response.Item result = 2;
+	.nested.Response.Item absolute = 3;
+}
+
+message Response {
+	message Item {
+		enum Kind {
+			A = 0;
+		}
+		uint64 count = 1;
+		Kind kind = 2;
+	}
+	Item item = 1;
+}`
+
+func TestNestedMessageDescriptors(t *testing.T) {
+	methods, err := GetMethods(nestedIDL)
+	require.NoError(t, err)
+	request := methods[0].Request
+
+	// the nested messages with the same name are distinguished by their qualified names
+	require.Len(t, request.Messages, 3)
+	require.Equal(t, Field{"item", 1, "Request.Item", false}, request.Messages[0].Fields[0])
+	require.Equal(t, Field{"result", 2, "Response.Item", false}, request.Messages[0].Fields[1])
+	require.Equal(t, Field{"absolute", 3, "Response.Item", false}, request.Messages[0].Fields[2])
+
+	item, ok := request.message("Response.Item")
+	require.True(t, ok)
+	require.Equal(t, Field{"kind", 2, TypeEnum, false}, item.Fields[1])
+
+	payload, err := request.EncodeJSON([]byte(`{"item":{"name":"iris"},"result":{"count":1}}`))
+	require.NoError(t, err)
+	require.NoError(t, request.Validate(payload))
+	_, err = request.EncodeJSON([]byte(`{"item":{"count":1}}`))
+	require.Error(t, err)
+}
+
+func TestEncodeAndValidatePayload(t *testing.T) {
+	methods, err := GetMethods(payloadIDL)
+	require.NoError(t, err)
+	request := methods[0].Request
+
+	payload, err := request.EncodeJSON([]byte(`{"name":"iris","values":[1,"-2"],"inner":{"flag":true,"delta":-3},"labels":{"a":1},"kind":1,"data":"AQI="}`))
+	require.NoError(t, err)
+	require.NoError(t, request.Validate(payload))
+	require.NoError(t, request.Validate(nil))
+
+	// packed repeated scalars
+	require.NoError(t, request.Validate([]byte{0x12, 0x02, 0x01, 0x02}))
+
+	// map entries are encoded in the order of keys
+	payload, err = request.EncodeJSON([]byte(`{"labels":{"b":2,"c":3,"a":1}}`))
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x22, 0x05, 0x0a, 0x01, 'a', 0x10, 0x01,
+		0x22, 0x05, 0x0a, 0x01, 'b', 0x10, 0x02,
+		0x22, 0x05, 0x0a, 0x01, 'c', 0x10, 0x03,
+	}, payload)
+
+	_, err = request.EncodeJSON([]byte(`{"unknown":1}`))
+	require.Error(t, err)
+	_, err = request.EncodeJSON([]byte(`{"name":1}`))
+	require.Error(t, err)
+	_, err = request.EncodeJSON([]byte(`{"inner":{"delta":"x"}}`))
+	require.Error(t, err)
+
+	// unknown field
+	require.Error(t, request.Validate([]byte{0x40, 0x01}))
+	// wrong wire type
+	require.Error(t, request.Validate([]byte{0x08, 0x01}))
+	// truncated
+	require.Error(t, request.Validate([]byte{0x0a, 0x05, 0x61}))
+	// invalid nested message
+	require.Error(t, request.Validate([]byte{0x1a, 0x02, 0x40, 0x01}))
+	// invalid utf-8 string
+	require.Error(t, request.Validate([]byte{0x0a, 0x01, 0xff}))
+}
@@ -0,0 +1,384 @@
+package protoidl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// protobuf wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// wireType returns the wire type of a non-packed field of the given type
+func wireType(typ string) int {
+	switch typ {
+	case "int32", "int64", "uint32", "uint64", "sint32", "sint64", "bool", TypeEnum:
+		return wireVarint
+	case "fixed64", "sfixed64", "double":
+		return wireFixed64
+	case "fixed32", "sfixed32", "float":
+		return wireFixed32
+	default:
+		return wireBytes
+	}
+}
+
+// Validate checks that the payload is a protobuf encoded message of the descriptor,
+// unknown fields are not allowed. An empty descriptor accepts any payload
+func (d MessageDescriptor) Validate(payload []byte) error {
+	if d.Empty() {
+		return nil
+	}
+	return d.validate(d.Messages[0], payload, 0)
+}
+
+func (d MessageDescriptor) validate(message Message, bz []byte, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("message %s is nested too deep", message.Name)
+	}
+
+	for len(bz) > 0 {
+		key, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return fmt.Errorf("invalid field key in message %s", message.Name)
+		}
+		bz = bz[n:]
+
+		number, wire := key>>3, int(key&7)
+		if number == 0 || number > math.MaxInt32 {
+			return fmt.Errorf("invalid field number %d in message %s", number, message.Name)
+		}
+		field, ok := message.field(int32(number))
+		if !ok {
+			return fmt.Errorf("unknown field %d in message %s", number, message.Name)
+		}
+
+		var value []byte
+		switch wire {
+		case wireVarint:
+			if _, n = binary.Uvarint(bz); n <= 0 {
+				return fmt.Errorf("invalid varint of field %s in message %s", field.Name, message.Name)
+			}
+		case wireFixed64:
+			n = 8
+		case wireFixed32:
+			n = 4
+		case wireBytes:
+			length, m := binary.Uvarint(bz)
+			if m <= 0 || length > uint64(len(bz)-m) {
+				return fmt.Errorf("invalid length of field %s in message %s", field.Name, message.Name)
+			}
+			value = bz[m : m+int(length)]
+			n = m + int(length)
+		default:
+			return fmt.Errorf("invalid wire type %d of field %s in message %s", wire, field.Name, message.Name)
+		}
+		if n > len(bz) {
+			return fmt.Errorf("field %s in message %s is truncated", field.Name, message.Name)
+		}
+		bz = bz[n:]
+
+		expected := wireType(field.Type)
+		if wire == expected {
+			if err := d.validateValue(field, value, depth); err != nil {
+				return err
+			}
+			continue
+		}
+		// repeated scalars may be packed
+		if wire == wireBytes && field.Repeated && expected != wireBytes {
+			if err := validatePacked(field, value); err != nil {
+				return fmt.Errorf("%s in message %s", err, message.Name)
+			}
+			continue
+		}
+		return fmt.Errorf("field %s in message %s has wire type %d, expected %d", field.Name, message.Name, wire, expected)
+	}
+	return nil
+}
+
+func (d MessageDescriptor) validateValue(field Field, value []byte, depth int) error {
+	switch field.Type {
+	case "string":
+		if !utf8.Valid(value) {
+			return fmt.Errorf("field %s is not a valid utf-8 string", field.Name)
+		}
+	case "bytes":
+	default:
+		if wireType(field.Type) != wireBytes {
+			return nil
+		}
+		message, ok := d.message(field.Type)
+		if !ok {
+			return fmt.Errorf("message %s is not described", field.Type)
+		}
+		return d.validate(message, value, depth+1)
+	}
+	return nil
+}
+
+func validatePacked(field Field, bz []byte) error {
+	switch wireType(field.Type) {
+	case wireVarint:
+		for len(bz) > 0 {
+			_, n := binary.Uvarint(bz)
+			if n <= 0 {
+				return fmt.Errorf("invalid packed varint of field %s", field.Name)
+			}
+			bz = bz[n:]
+		}
+	case wireFixed64:
+		if len(bz)%8 != 0 {
+			return fmt.Errorf("invalid packed length of field %s", field.Name)
+		}
+	case wireFixed32:
+		if len(bz)%4 != 0 {
+			return fmt.Errorf("invalid packed length of field %s", field.Name)
+		}
+	}
+	return nil
+}
+
+// EncodeJSON encodes the json object to a protobuf message of the descriptor.
+// The json is of the proto3 json mapping, except that enums are given by their numbers
+func (d MessageDescriptor) EncodeJSON(bz []byte) ([]byte, error) {
+	if d.Empty() {
+		return nil, fmt.Errorf("the message is not described")
+	}
+
+	var object interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return d.encodeMessage(d.Messages[0], object, 0)
+}
+
+func (d MessageDescriptor) encodeMessage(message Message, value interface{}, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("message %s is nested too deep", message.Name)
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("message %s must be a json object", message.Name)
+	}
+	for name := range object {
+		if !message.hasField(name) {
+			return nil, fmt.Errorf("unknown field %s in message %s", name, message.Name)
+		}
+	}
+
+	var buf []byte
+	var err error
+	// encode fields in the order of definition to get a deterministic payload
+	for _, field := range message.Fields {
+		value, ok := object[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+		if !field.Repeated {
+			if buf, err = d.appendField(buf, field, value, depth); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		var values []interface{}
+		if entry, ok := d.message(field.Type); ok && entry.MapEntry {
+			entries, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("map field %s in message %s must be a json object", field.Name, message.Name)
+			}
+			// encode map entries in the order of keys to get a deterministic payload
+			keys := make([]string, 0, len(entries))
+			for k := range entries {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				values = append(values, map[string]interface{}{"key": k, "value": entries[k]})
+			}
+		} else if values, ok = value.([]interface{}); !ok {
+			return nil, fmt.Errorf("repeated field %s in message %s must be a json array", field.Name, message.Name)
+		}
+		for _, v := range values {
+			if buf, err = d.appendField(buf, field, v, depth); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (m Message) hasField(name string) bool {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (d MessageDescriptor) appendField(buf []byte, field Field, value interface{}, depth int) ([]byte, error) {
+	wire := wireType(field.Type)
+	buf = appendUvarint(buf, uint64(field.Number)<<3|uint64(wire))
+
+	switch field.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %s must be a string", field.Name)
+		}
+		return appendBytes(buf, []byte(s)), nil
+	case "bytes":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %s must be a base64 string", field.Name)
+		}
+		bz, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("field %s must be a base64 string", field.Name)
+		}
+		return appendBytes(buf, bz), nil
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("field %s must be a boolean", field.Name)
+		}
+		if b {
+			return appendUvarint(buf, 1), nil
+		}
+		return appendUvarint(buf, 0), nil
+	}
+
+	if wire == wireBytes {
+		message, ok := d.message(field.Type)
+		if !ok {
+			return nil, fmt.Errorf("message %s is not described", field.Type)
+		}
+		bz, err := d.encodeMessage(message, value, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(buf, bz), nil
+	}
+
+	// numbers are given as json numbers or strings
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("field %s must be a number", field.Name)
+	}
+	return appendNumber(buf, field, s)
+}
+
+func appendBytes(buf, bz []byte) []byte {
+	buf = appendUvarint(buf, uint64(len(bz)))
+	return append(buf, bz...)
+}
+
+func appendNumber(buf []byte, field Field, s string) ([]byte, error) {
+	var err error
+	switch field.Type {
+	case "int32", TypeEnum:
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 32); err == nil {
+			return appendUvarint(buf, uint64(v)), nil
+		}
+	case "int64":
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 64); err == nil {
+			return appendUvarint(buf, uint64(v)), nil
+		}
+	case "uint32", "uint64":
+		var v uint64
+		if v, err = strconv.ParseUint(s, 10, bitSize(field.Type)); err == nil {
+			return appendUvarint(buf, v), nil
+		}
+	case "sint32", "sint64":
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, bitSize(field.Type)); err == nil {
+			return appendVarint(buf, v), nil
+		}
+	case "fixed32":
+		var v uint64
+		if v, err = strconv.ParseUint(s, 10, 32); err == nil {
+			return appendUint32(buf, uint32(v)), nil
+		}
+	case "sfixed32":
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 32); err == nil {
+			return appendUint32(buf, uint32(v)), nil
+		}
+	case "fixed64":
+		var v uint64
+		if v, err = strconv.ParseUint(s, 10, 64); err == nil {
+			return appendUint64(buf, v), nil
+		}
+	case "sfixed64":
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 64); err == nil {
+			return appendUint64(buf, uint64(v)), nil
+		}
+	case "float":
+		var v float64
+		if v, err = strconv.ParseFloat(s, 32); err == nil {
+			return appendUint32(buf, math.Float32bits(float32(v))), nil
+		}
+	case "double":
+		var v float64
+		if v, err = strconv.ParseFloat(s, 64); err == nil {
+			return appendUint64(buf, math.Float64bits(v)), nil
+		}
+	default:
+		return nil, fmt.Errorf("field %s has unknown type %s", field.Name, field.Type)
+	}
+	return nil, fmt.Errorf("field %s is not a valid %s: %s", field.Name, field.Type, err)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	scratch := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(scratch, v)
+	return append(buf, scratch[:n]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	scratch := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(scratch, v)
+	return append(buf, scratch[:n]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	scratch := make([]byte, 4)
+	binary.LittleEndian.PutUint32(scratch, v)
+	return append(buf, scratch...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	scratch := make([]byte, 8)
+	binary.LittleEndian.PutUint64(scratch, v)
+	return append(buf, scratch...)
+}
+
+func bitSize(typ string) int {
+	if typ == "uint32" || typ == "sint32" {
+		return 32
+	}
+	return 64
+}
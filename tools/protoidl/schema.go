@@ -0,0 +1,209 @@
+package protoidl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+// type of the enum fields, which are encoded as int32
+const TypeEnum = "enum"
+
+// the maximal nesting depth of the messages to be validated or encoded
+const maxDepth = 32
+
+var scalarTypes = map[string]bool{
+	"double": true, "float": true,
+	"int32": true, "int64": true, "uint32": true, "uint64": true, "sint32": true, "sint64": true,
+	"fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+	"bool": true, "string": true, "bytes": true,
+}
+
+// Field describes a field of a protobuf message
+type Field struct {
+	Name     string `json:"name"`
+	Number   int32  `json:"number"`
+	Type     string `json:"type"` // scalar type, enum or the name of a message
+	Repeated bool   `json:"repeated"`
+}
+
+// Message describes a protobuf message
+type Message struct {
+	Name     string  `json:"name"`
+	Fields   []Field `json:"fields"`
+	MapEntry bool    `json:"map_entry"` // the key/value entry of a map field
+}
+
+// MessageDescriptor describes a message together with all the messages it refers to,
+// the first one is the described message. An empty descriptor describes nothing
+type MessageDescriptor struct {
+	Messages []Message `json:"messages"`
+}
+
+// Empty returns true if the descriptor describes nothing
+func (d MessageDescriptor) Empty() bool {
+	return len(d.Messages) == 0
+}
+
+func (d MessageDescriptor) message(name string) (Message, bool) {
+	for _, m := range d.Messages {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return Message{}, false
+}
+
+func (m Message) field(number int32) (Field, bool) {
+	for _, f := range m.Fields {
+		if f.Number == number {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// schema holds all the messages and enums defined in an idl, keyed by their fully qualified names
+// within the package, e.g. Outer.Inner for a message Inner nested in the message Outer
+type schema struct {
+	pkg      string
+	messages map[string]Message
+	enums    map[string]bool
+}
+
+func newSchema(definition *proto.Proto) schema {
+	s := schema{
+		messages: make(map[string]Message),
+		enums:    make(map[string]bool),
+	}
+	for _, element := range definition.Elements {
+		if p, ok := element.(*proto.Package); ok {
+			s.pkg = p.Name
+		}
+	}
+	// all the names are collected first, as a message can refer to the ones defined after it
+	proto.Walk(definition,
+		proto.WithEnum(func(e *proto.Enum) {
+			s.enums[qualifiedName(e.Parent, e.Name)] = true
+		}),
+		proto.WithMessage(func(m *proto.Message) {
+			if !m.IsExtend {
+				name := qualifiedName(m.Parent, m.Name)
+				s.messages[name] = Message{Name: name}
+			}
+		}),
+	)
+	proto.Walk(definition,
+		proto.WithMessage(func(m *proto.Message) {
+			if !m.IsExtend {
+				s.addMessage(m)
+			}
+		}),
+	)
+	return s
+}
+
+// qualifiedName returns the name of a message or enum prefixed by the names of the messages it is nested in
+func qualifiedName(parent proto.Visitee, name string) string {
+	for {
+		m, ok := parent.(*proto.Message)
+		if !ok {
+			return name
+		}
+		name = m.Name + "." + name
+		parent = m.Parent
+	}
+}
+
+func (s schema) addMessage(m *proto.Message) {
+	scope := qualifiedName(m.Parent, m.Name)
+	message := Message{Name: scope}
+	var addFields func(elements []proto.Visitee)
+	addFields = func(elements []proto.Visitee) {
+		for _, element := range elements {
+			switch f := element.(type) {
+			case *proto.NormalField:
+				message.Fields = append(message.Fields, Field{f.Name, int32(f.Sequence), s.resolve(f.Type, scope), f.Repeated})
+			case *proto.OneOfField:
+				message.Fields = append(message.Fields, Field{f.Name, int32(f.Sequence), s.resolve(f.Type, scope), false})
+			case *proto.Oneof:
+				addFields(f.Elements)
+			case *proto.MapField:
+				entry := Message{
+					Name:     fmt.Sprintf("%s.%sEntry", scope, f.Name),
+					Fields:   []Field{{"key", 1, s.resolve(f.KeyType, scope), false}, {"value", 2, s.resolve(f.Type, scope), false}},
+					MapEntry: true,
+				}
+				s.messages[entry.Name] = entry
+				message.Fields = append(message.Fields, Field{f.Name, int32(f.Sequence), entry.Name, true})
+			}
+		}
+	}
+	addFields(m.Elements)
+	s.messages[scope] = message
+}
+
+// resolve the type of a field referred in the scope of a message to a scalar type, enum or the qualified name
+// of a message. Like protobuf, the name is searched from the innermost scope to the outermost one
+func (s schema) resolve(typ string, scope string) string {
+	if scalarTypes[typ] {
+		return typ
+	}
+
+	var candidates []string
+	if strings.HasPrefix(typ, ".") {
+		candidates = []string{strings.TrimPrefix(typ[1:], s.pkg+".")}
+	} else {
+		for len(scope) > 0 {
+			candidates = append(candidates, scope+"."+typ)
+			if i := strings.LastIndex(scope, "."); i >= 0 {
+				scope = scope[:i]
+			} else {
+				scope = ""
+			}
+		}
+		candidates = append(candidates, typ)
+		if len(s.pkg) > 0 && strings.HasPrefix(typ, s.pkg+".") {
+			candidates = append(candidates, strings.TrimPrefix(typ, s.pkg+"."))
+		}
+	}
+
+	for _, name := range candidates {
+		if s.enums[name] {
+			return TypeEnum
+		}
+		if _, ok := s.messages[name]; ok {
+			return name
+		}
+	}
+	// unknown messages, e.g. imported from other files, are left unresolved
+	return typ
+}
+
+// descriptor returns the descriptor of the given message with all the messages it refers to
+func (s schema) descriptor(typ string) (d MessageDescriptor, err error) {
+	visited := make(map[string]bool)
+	queue := []string{s.resolve(typ, "")}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		message, ok := s.messages[name]
+		if !ok {
+			return MessageDescriptor{}, fmt.Errorf("message %s is not defined", name)
+		}
+		d.Messages = append(d.Messages, message)
+
+		for _, f := range message.Fields {
+			if !scalarTypes[f.Type] && f.Type != TypeEnum {
+				queue = append(queue, f.Type)
+			}
+		}
+	}
+	return d, nil
+}
@@ -3,4 +3,6 @@ package protoidl
 type Method struct {
 	Name       string
 	Attributes map[string]string
+	Request    MessageDescriptor // descriptor of the request message, empty if it is not defined in the idl
+	Response   MessageDescriptor // descriptor of the response message, empty if it is not defined in the idl
 }
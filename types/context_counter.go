@@ -1,7 +1,8 @@
 package types
 
 type ValidTxCounter struct {
-	count int64
+	count      int64
+	invalidTxs [][]byte
 }
 
 func (vtc *ValidTxCounter) Count() int64 {
@@ -12,6 +13,15 @@ func (vtc *ValidTxCounter) Incr() {
 	vtc.count++
 }
 
+// hashes of the txs which failed before being counted as valid
+func (vtc *ValidTxCounter) InvalidTxs() [][]byte {
+	return vtc.invalidTxs
+}
+
+func (vtc *ValidTxCounter) AddInvalidTx(txHash []byte) {
+	vtc.invalidTxs = append(vtc.invalidTxs, txHash)
+}
+
 func NewValidTxCounter() *ValidTxCounter {
 	return &ValidTxCounter{
 		count: 0,
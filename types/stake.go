@@ -48,6 +48,7 @@ type Validator interface {
 	GetCommission() Dec           // validator commission rate
 	GetDelegatorShares() Dec      // Total out standing delegator shares
 	GetBondHeight() int64         // height in which the validator became active
+	GetMinSelfDelegation() Int    // minimum tokens of the operator's self-delegation
 }
 
 // validator which fulfills abci validator interface for use in Tendermint
@@ -32,6 +32,9 @@ const (
 	CoinHTLCCreateFlow          = "CreateHTLC"
 	CoinHTLCClaimFlow           = "ClaimHTLC"
 	CoinHTLCRefundFlow          = "RefundHTLC"
+	TokenVestingLockFlow        = "TokenVestingLock"
+	TokenVestingReleaseFlow     = "TokenVestingRelease"
+	EvidenceRewardFlow          = "EvidenceReward"
 
 	//Trigger: transaction hash, module endBlock and beginBlock
 	GovEndBlocker            = "govEndBlocker"
@@ -39,6 +42,7 @@ const (
 	SlashEndBlocker          = "slashEndBlocker"
 	StakeEndBlocker          = "stakeEndBlocker"
 	ServiceEndBlocker        = "serviceEndBlocker"
+	AssetEndBlocker          = "assetEndBlocker"
 	DistributionBeginBlocker = "distributionBeginBlocker"
 )
 